@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"load-generation-system/internal/core"
+	"load-generation-system/pkg/grpc/go/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LeaseService implements the gRPC LeaseServer interface and lets the users of the nodes
+// acquire and release the resources of the lease pools held by the manager.
+type LeaseService struct {
+	leaseService core.LeaseService // Core service for lease pool management
+
+	pb.UnimplementedLeaseServer
+}
+
+func NewLeaseService(leaseService core.LeaseService) *LeaseService {
+	return &LeaseService{
+		leaseService: leaseService,
+	}
+}
+
+// AcquireLease leases a free resource of a pool to a user of a node.
+// The call waits until a resource is released, bounded by the deadline of the caller.
+//
+// Parameters:
+//   - ctx: Context of the call, done when the node gives up waiting
+//   - acquire: The node process and the pool of the requested resource
+//
+// Returns:
+//   - *pb.LeaseGrant: The granted lease
+//   - error: NotFound status if the pool doesn't exist, the context status if the wait is abandoned
+func (service *LeaseService) AcquireLease(ctx context.Context, acquire *pb.LeaseAcquire) (*pb.LeaseGrant, error) {
+	lease, err := service.leaseService.Acquire(ctx, acquire.NodeName, acquire.InstanceId, acquire.Pool)
+	if err != nil {
+		return nil, mapLeaseError(err)
+	}
+
+	return &pb.LeaseGrant{
+		Id:       lease.ID,
+		Pool:     lease.Pool,
+		Resource: lease.Resource,
+	}, nil
+}
+
+// ReleaseLease returns a leased resource to its pool.
+//
+// Parameters:
+//   - ctx: Context of the call
+//   - grant: The lease to release
+//
+// Returns:
+//   - *pb.LeaseRelease: Empty response once the lease is released
+//   - error: NotFound status if the lease was already released
+func (service *LeaseService) ReleaseLease(_ context.Context, grant *pb.LeaseGrant) (*pb.LeaseRelease, error) {
+	err := service.leaseService.Release(core.Lease{
+		ID:       grant.Id,
+		Pool:     grant.Pool,
+		Resource: grant.Resource,
+	})
+	if err != nil {
+		return nil, mapLeaseError(err)
+	}
+
+	return &pb.LeaseRelease{}, nil
+}
+
+// mapLeaseError converts a lease error to its gRPC status.
+//
+// Parameters:
+//   - err: The error to convert
+//
+// Returns:
+//   - error: The gRPC status of the error
+func mapLeaseError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrLeasePoolNotFound), errors.Is(err, core.ErrLeaseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
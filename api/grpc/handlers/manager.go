@@ -70,6 +70,7 @@ func (service *Service) StreamAttack(stream pb.Attack_StreamAttackServer) error
 	// Create and start new node instance
 	n := node.New(
 		val.Handshake.NodeName,
+		val.Handshake.InstanceId,
 		scenarios,
 		ops,
 		service.nodeOpQueueCapacity,
@@ -95,8 +96,8 @@ func (service *Service) StreamAttack(stream pb.Attack_StreamAttackServer) error
 	return service.runSender(ctx, stream, ops)
 }
 
-// runReceiver handles incoming messages from the node, primarily acknowledgments
-// of completed operations.
+// runReceiver handles incoming messages from the node: acknowledgments
+// of completed operations, attack statistics reports and increment completions.
 //
 // Parameters:
 //   - ctx: Context for cancellation
@@ -125,14 +126,24 @@ func (service *Service) runReceiver(
 			return
 		}
 
-		// Validate message is an acknowledgment
-		if _, ok := resp.Request.(*pb.AttackRequest_Acknowledge); !ok {
-			log.Printf("unable to cast request to acknowledge")
+		switch val := resp.Request.(type) {
+		case *pb.AttackRequest_Acknowledge:
+			// Notify node of received acknowledgment
+			n.AckOperation()
+		case *pb.AttackRequest_Report:
+			// Pass attack statistics to the attack service before the completions may end the attacks
+			if stats := service.mapReportToCore(val.Report); len(stats) != 0 {
+				service.attackService.ReportStats(stats)
+			}
+
+			// Completions may stop the increments, which waits for the acknowledgments read by this loop
+			for _, completion := range service.mapCompletionsToCore(val.Report) {
+				go service.attackService.CompleteIncrement(n.GetDetails().Name, completion)
+			}
+		default:
+			log.Printf("unable to cast request to acknowledge or report")
 			return
 		}
-
-		// Notify node of received acknowledgment
-		n.AckOperation()
 	}
 }
 
@@ -167,6 +178,16 @@ func (service *Service) runSender(
 				response = service.mapStartFromCore(*op.Start)
 			} else if op.Stop != nil {
 				response = service.mapStopFromCore(*op.Stop)
+			} else if op.Reduce != nil {
+				response = service.mapReduceFromCore(*op.Reduce)
+			} else if op.Rescale != nil {
+				response = service.mapRescaleFromCore(*op.Rescale)
+			} else if op.Pause != nil {
+				response = service.mapPauseFromCore(*op.Pause)
+			} else if op.Resume != nil {
+				response = service.mapResumeFromCore(*op.Resume)
+			} else if op.Scenario != nil {
+				response = service.mapScenarioFromCore(*op.Scenario)
 			} else if op.Kill != nil {
 				response = &pb.AttackResponse{
 					Response: &pb.AttackResponse_Kill{
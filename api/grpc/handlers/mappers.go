@@ -1,38 +1,225 @@
 package handlers
 
 import (
+	"encoding/json"
 	"load-generation-system/internal/core"
 	"load-generation-system/pkg/grpc/go/pb"
+	"log"
 )
 
 func (service *Service) mapScenarioToCore(scenario *pb.Scenario) core.ScenarioDetails {
+	var parameters []core.ScenarioParameter
+	for _, parameter := range scenario.Parameters {
+		var defaultValue any
+		if len(parameter.Default) != 0 {
+			if err := json.Unmarshal(parameter.Default, &defaultValue); err != nil {
+				log.Printf("bad default of parameter %s of scenario %s: %v", parameter.Name, scenario.Name, err)
+			}
+		}
+
+		parameters = append(parameters, core.ScenarioParameter{
+			Name:        parameter.Name,
+			Type:        core.ParameterType(parameter.Type),
+			Description: parameter.Description,
+			Required:    parameter.Required,
+			Default:     defaultValue,
+		})
+	}
+
 	return core.ScenarioDetails{
 		Name:        scenario.Name,
 		Description: scenario.Description,
+		Parameters:  parameters,
 	}
 }
 
 func (service *Service) mapStartFromCore(start core.OperationStart) *pb.AttackResponse {
+	arrivalRates := service.mapArrivalRatesFromCore(start.ArrivalRates)
+
+	pacings := make(map[string]*pb.Pacing, len(start.Pacings))
+	for scenario, pacing := range start.Pacings {
+		pacings[scenario] = &pb.Pacing{
+			Mode:        string(pacing.Mode),
+			IntervalSec: pacing.IntervalSec,
+			MinSec:      pacing.MinSec,
+			MaxSec:      pacing.MaxSec,
+		}
+	}
+
+	params := make(map[string][]byte, len(start.Params))
+	for scenario, scenarioParams := range start.Params {
+		encoded, err := json.Marshal(scenarioParams)
+		if err != nil {
+			log.Printf("impossible to encode parameters of scenario %s: %v", scenario, err)
+			continue
+		}
+		params[scenario] = encoded
+	}
+
+	feeds := make([]*pb.FeedShare, 0, len(start.Feeds))
+	for _, share := range start.Feeds {
+		rows := make([]*pb.DataRow, 0, len(share.Rows))
+		for _, row := range share.Rows {
+			rows = append(rows, &pb.DataRow{Values: row})
+		}
+		feeds = append(feeds, &pb.FeedShare{
+			Dataset: share.Dataset,
+			Mode:    string(share.Mode),
+			Rows:    rows,
+		})
+	}
+
 	return &pb.AttackResponse{
 		Response: &pb.AttackResponse_Start{
 			Start: &pb.OperationStart{
-				Id:          start.ID,
-				AttackId:    start.AttackID,
-				IncrementId: start.IncrementID,
-				WaitTimeSec: float32(start.WaitTimeSec), // nolint: unconvertable types from int64 to float32
-				Scenarios:   start.Scenarios,
+				Id:                start.ID,
+				AttackId:          start.AttackID,
+				IncrementId:       start.IncrementID,
+				WaitTimeSec:       float32(start.WaitTimeSec), // nolint: unconvertable types from int64 to float32
+				Scenarios:         start.Scenarios,
+				ArrivalRates:      arrivalRates,
+				Pacings:           pacings,
+				Iterations:        start.Iterations,
+				IterationsPerUser: start.IterationsPerUser,
+				Target:            service.mapTargetFromCore(start.Target),
+				Params:            params,
+				Feeds:             feeds,
 			},
 		},
 	}
 }
 
+func (service *Service) mapArrivalRatesFromCore(rates map[string]core.ArrivalRate) map[string]*pb.ArrivalRate {
+	arrivalRates := make(map[string]*pb.ArrivalRate, len(rates))
+	for scenario, rate := range rates {
+		arrivalRates[scenario] = &pb.ArrivalRate{
+			Rate:       rate.Rate,
+			TargetRate: rate.TargetRate,
+			RampUpSec:  rate.RampUpSec,
+		}
+	}
+
+	return arrivalRates
+}
+
+func (service *Service) mapTargetFromCore(target *core.Target) *pb.Target {
+	if target == nil {
+		return nil
+	}
+
+	return &pb.Target{
+		Name:     target.Name,
+		Services: target.Services,
+		Headers:  target.Headers,
+	}
+}
+
 func (service *Service) mapStopFromCore(stop core.OperationStop) *pb.AttackResponse {
 	return &pb.AttackResponse{
 		Response: &pb.AttackResponse_Stop{
 			Stop: &pb.OperationStop{
-				AttackId:    stop.AttackID,
-				IncrementId: stop.IncrementID,
+				AttackId:        stop.AttackID,
+				IncrementId:     stop.IncrementID,
+				DrainTimeoutSec: stop.DrainTimeoutSec,
+			},
+		},
+	}
+}
+
+func (service *Service) mapReduceFromCore(reduce core.OperationReduce) *pb.AttackResponse {
+	return &pb.AttackResponse{
+		Response: &pb.AttackResponse_Reduce{
+			Reduce: &pb.OperationReduce{
+				AttackId:    reduce.AttackID,
+				IncrementId: reduce.IncrementID,
+				Scenarios:   reduce.Scenarios,
+			},
+		},
+	}
+}
+
+func (service *Service) mapRescaleFromCore(rescale core.OperationRescale) *pb.AttackResponse {
+	return &pb.AttackResponse{
+		Response: &pb.AttackResponse_Rescale{
+			Rescale: &pb.OperationRescale{
+				AttackId:     rescale.AttackID,
+				IncrementId:  rescale.IncrementID,
+				ArrivalRates: service.mapArrivalRatesFromCore(rescale.ArrivalRates),
+			},
+		},
+	}
+}
+
+func (service *Service) mapPauseFromCore(pause core.OperationPause) *pb.AttackResponse {
+	return &pb.AttackResponse{
+		Response: &pb.AttackResponse_Pause{
+			Pause: &pb.OperationPause{
+				AttackId: pause.AttackID,
 			},
 		},
 	}
 }
+
+func (service *Service) mapResumeFromCore(resume core.OperationResume) *pb.AttackResponse {
+	return &pb.AttackResponse{
+		Response: &pb.AttackResponse_Resume{
+			Resume: &pb.OperationResume{
+				AttackId: resume.AttackID,
+			},
+		},
+	}
+}
+
+func (service *Service) mapScenarioFromCore(scenario core.OperationScenario) *pb.AttackResponse {
+	return &pb.AttackResponse{
+		Response: &pb.AttackResponse_Scenario{
+			Scenario: &pb.OperationScenario{
+				Name:        scenario.Name,
+				Description: scenario.Description,
+				Version:     scenario.Version,
+				Definition:  scenario.Definition,
+			},
+		},
+	}
+}
+
+func (service *Service) mapReportToCore(report *pb.Report) []core.AttackStats {
+	stats := make([]core.AttackStats, 0, len(report.Stats))
+	for _, attack := range report.Stats {
+		var checks map[string]core.CheckStats
+		if len(attack.Checks) != 0 {
+			checks = make(map[string]core.CheckStats, len(attack.Checks))
+			for name, check := range attack.Checks {
+				checks[name] = core.CheckStats{
+					Passed: check.Passed,
+					Failed: check.Failed,
+				}
+			}
+		}
+
+		stats = append(stats, core.AttackStats{
+			AttackID:      attack.AttackId,
+			Requests:      attack.Requests,
+			Errors:        attack.Errors,
+			Bounds:        attack.Bounds,
+			Buckets:       attack.Buckets,
+			Checks:        checks,
+			SetupFailures: attack.SetupFailures,
+		})
+	}
+
+	return stats
+}
+
+func (service *Service) mapCompletionsToCore(report *pb.Report) []core.IncrementCompletion {
+	completions := make([]core.IncrementCompletion, 0, len(report.Completions))
+	for _, completion := range report.Completions {
+		completions = append(completions, core.IncrementCompletion{
+			AttackID:    completion.AttackId,
+			IncrementID: completion.IncrementId,
+			Iterations:  completion.Iterations,
+		})
+	}
+
+	return completions
+}
@@ -11,17 +11,23 @@ type (
 		grpcServer *grpc.Server
 		// services
 		attackService *Service
+		leaseService  *LeaseService
+		setupService  *SetupService
 	}
 )
 
 func NewResolver(
 	grpcServer *grpc.Server,
 	attackService *Service,
+	leaseService *LeaseService,
+	setupService *SetupService,
 ) *Resolver {
 	return &Resolver{
 		grpcServer: grpcServer,
 
 		attackService: attackService,
+		leaseService:  leaseService,
+		setupService:  setupService,
 	}
 }
 
@@ -33,6 +39,8 @@ func (resolver Resolver) Run(ctx context.Context) {
 
 func (resolver Resolver) init() {
 	pb.RegisterAttackServer(resolver.grpcServer.Server(), resolver.attackService)
+	pb.RegisterLeaseServer(resolver.grpcServer.Server(), resolver.leaseService)
+	pb.RegisterSetupServer(resolver.grpcServer.Server(), resolver.setupService)
 }
 
 func (resolver Resolver) Shutdown() error {
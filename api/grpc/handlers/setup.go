@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"load-generation-system/internal/core"
+	"load-generation-system/pkg/grpc/go/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetupService implements the gRPC SetupServer interface and lets the nodes run the attack-level setup
+// of a scenario once per attack, the nodes not running it waiting for its outcome.
+type SetupService struct {
+	attackService core.AttackService // Core service for attack management
+
+	pb.UnimplementedSetupServer
+}
+
+func NewSetupService(attackService core.AttackService) *SetupService {
+	return &SetupService{
+		attackService: attackService,
+	}
+}
+
+// ClaimSetup lets the first node claiming the attack-level setup of a scenario run it.
+// The call of the other nodes waits until the setup is over, bounded by the deadline of the caller.
+//
+// Parameters:
+//   - ctx: Context of the call, done when the node gives up waiting
+//   - claim: The node, the attack and the scenario of the setup
+//
+// Returns:
+//   - *pb.SetupTurn: Whether the node must run the setup
+//   - error: NotFound status if the attack doesn't exist, FailedPrecondition status if the setup has failed,
+//     the context status if the wait is abandoned
+func (service *SetupService) ClaimSetup(ctx context.Context, claim *pb.SetupClaim) (*pb.SetupTurn, error) {
+	run, err := service.attackService.ClaimSetup(ctx, claim.NodeName, claim.AttackId, claim.Scenario)
+	if err != nil {
+		return nil, mapSetupError(err)
+	}
+
+	return &pb.SetupTurn{
+		Run: run,
+	}, nil
+}
+
+// CompleteSetup records the outcome of the attack-level setup of a scenario run by a node.
+//
+// Parameters:
+//   - ctx: Context of the call
+//   - result: The node, the attack, the scenario and the error of the setup
+//
+// Returns:
+//   - *pb.SetupCompletion: Empty response once the outcome is recorded
+//   - error: NotFound status if the attack doesn't exist
+func (service *SetupService) CompleteSetup(_ context.Context, result *pb.SetupResult) (*pb.SetupCompletion, error) {
+	err := service.attackService.CompleteSetup(result.NodeName, result.AttackId, result.Scenario, result.Failure)
+	if err != nil {
+		return nil, mapSetupError(err)
+	}
+
+	return &pb.SetupCompletion{}, nil
+}
+
+// mapSetupError converts a setup error to its gRPC status.
+//
+// Parameters:
+//   - err: The error to convert
+//
+// Returns:
+//   - error: The gRPC status of the error
+func mapSetupError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrAttackNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrSetupFailed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -8,6 +8,12 @@ import (
 	"load-generation-system/pkg/rest"
 
 	"load-generation-system/internal/service/attack"
+	"load-generation-system/internal/service/dataset"
+	"load-generation-system/internal/service/lease"
+	"load-generation-system/internal/service/schedule"
+	"load-generation-system/internal/service/suite"
+	"load-generation-system/internal/service/target"
+	"load-generation-system/internal/service/template"
 
 	"github.com/google/wire"
 	"github.com/urfave/cli/v2"
@@ -19,9 +25,17 @@ var managerSet = wire.NewSet( // nolint
 	restHandlers.NewResolver,
 	provideManagerGRPCConfig,
 	provideManagerService,
+	handlers.NewLeaseService,
+	handlers.NewSetupService,
 	handlers.NewResolver,
 	grpcserver.New,
 	provideAttackService,
+	provideScheduleService,
+	provideTemplateService,
+	provideSuiteService,
+	provideTargetService,
+	provideDatasetService,
+	provideLeaseService,
 	rest.New,
 )
 
@@ -40,12 +54,48 @@ func provideManagerGRPCConfig(c *cli.Context) grpcserver.Config {
 	}
 }
 
-func provideAttackService(c *cli.Context) core.AttackService {
+func provideAttackService(
+	c *cli.Context,
+	targetService core.TargetService,
+	datasetService core.DatasetService,
+	leaseService core.LeaseService,
+) core.AttackService {
 	return attack.NewService(
+		targetService,
+		datasetService,
+		leaseService,
 		c.Int64("recovery-interval-sec"),
 	)
 }
 
+func provideScheduleService(attackService core.AttackService) core.ScheduleService {
+	return schedule.NewService(
+		attackService,
+	)
+}
+
+func provideTemplateService() core.TemplateService {
+	return template.NewService()
+}
+
+func provideTargetService() core.TargetService {
+	return target.NewService()
+}
+
+func provideDatasetService() core.DatasetService {
+	return dataset.NewService()
+}
+
+func provideLeaseService() core.LeaseService {
+	return lease.NewService()
+}
+
+func provideSuiteService(attackService core.AttackService) core.SuiteService {
+	return suite.NewService(
+		attackService,
+	)
+}
+
 func provideManagerService(c *cli.Context, attackService core.AttackService) *handlers.Service {
 	return handlers.NewService(
 		attackService,
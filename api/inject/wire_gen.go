@@ -21,12 +21,20 @@ import (
 func InitializeManager(c *cli.Context, appCtx context.Context) (api.ManagerContainer, error) {
 	config := provideManagerServerConfig(c)
 	restServer := rest.New(config)
-	attackService := provideAttackService(c)
-	resolver := handlers.NewResolver(restServer, attackService)
+	targetService := provideTargetService()
+	datasetService := provideDatasetService()
+	leaseService := provideLeaseService()
+	attackService := provideAttackService(c, targetService, datasetService, leaseService)
+	scheduleService := provideScheduleService(attackService)
+	templateService := provideTemplateService()
+	suiteService := provideSuiteService(attackService)
+	resolver := handlers.NewResolver(restServer, attackService, scheduleService, templateService, suiteService, targetService, datasetService, leaseService)
 	serverConfig := provideManagerGRPCConfig(c)
 	serverServer := server.New(appCtx, serverConfig)
 	service := provideManagerService(c, attackService)
-	handlersResolver := handlers2.NewResolver(serverServer, service)
+	handlersLeaseService := handlers2.NewLeaseService(leaseService)
+	setupService := handlers2.NewSetupService(attackService)
+	handlersResolver := handlers2.NewResolver(serverServer, service, handlersLeaseService, setupService)
 	managerContainer := api.NewManagerContainer(restServer, resolver, handlersResolver)
 	return managerContainer, nil
 }
@@ -16,6 +16,10 @@ type GRPCConn struct {
 	Conn *grpc.ClientConn
 }
 
+type NodeInstance struct {
+	ID string
+}
+
 type NodeContainer struct {
 	AttackGateway     core.AttackGateway
 	Server            rest.Server
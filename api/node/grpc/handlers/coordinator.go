@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"load-generation-system/internal/core"
+	"load-generation-system/pkg/grpc/go/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupCoordinator implements the core.SetupCoordinator interface and claims the attack-level setups
+// of the scenarios on the manager, so that a single node runs each of them.
+type setupCoordinator struct {
+	setupClient pb.SetupClient // gRPC client for setup service communication
+	nodeName    string         // Identifier for this node, the setups it runs are reopened if it is given up
+}
+
+func NewSetupCoordinator(setupClient pb.SetupClient, nodeName string) core.SetupCoordinator {
+	return &setupCoordinator{
+		setupClient: setupClient,
+		nodeName:    nodeName,
+	}
+}
+
+// Claim asks the manager to run the attack-level setup of a scenario, waiting while another node runs it.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//   - attackID: ID of the attack
+//   - scenario: Name of the scenario
+//
+// Returns:
+//   - bool: true if the node must run the setup, false if another node has run it successfully
+//   - error: core.ErrSetupFailed if the setup has failed on another node, core.ErrAttackNotFound if the attack has ended,
+//     the context error if it is done first
+func (c *setupCoordinator) Claim(ctx context.Context, attackID int64, scenario string) (bool, error) {
+	turn, err := c.setupClient.ClaimSetup(ctx, &pb.SetupClaim{
+		NodeName: c.nodeName,
+		AttackId: attackID,
+		Scenario: scenario,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return false, mapSetupStatus(err)
+	}
+
+	return turn.Run, nil
+}
+
+// Complete reports the outcome of a claimed setup to the manager.
+//
+// Parameters:
+//   - ctx: Context of the report
+//   - attackID: ID of the attack
+//   - scenario: Name of the scenario
+//   - failure: The setup error, nil if the setup succeeded
+//
+// Returns:
+//   - error: core.ErrAttackNotFound if the attack has ended
+func (c *setupCoordinator) Complete(ctx context.Context, attackID int64, scenario string, failure error) error {
+	result := &pb.SetupResult{
+		NodeName: c.nodeName,
+		AttackId: attackID,
+		Scenario: scenario,
+	}
+	if failure != nil {
+		result.Failure = failure.Error()
+	}
+
+	_, err := c.setupClient.CompleteSetup(ctx, result)
+	if err != nil {
+		return mapSetupStatus(err)
+	}
+
+	return nil
+}
+
+// mapSetupStatus converts a gRPC status of the setup service to its core error.
+//
+// Parameters:
+//   - err: The gRPC status
+//
+// Returns:
+//   - error: The core error of the status
+func mapSetupStatus(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return core.ErrAttackNotFound
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", core.ErrSetupFailed, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"load-generation-system/internal/core"
+	"load-generation-system/pkg/grpc/go/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// leaser implements the core.Leaser interface and acquires the resources of the lease pools
+// held by the manager on behalf of the users of the node.
+type leaser struct {
+	leaseClient pb.LeaseClient // gRPC client for lease service communication
+	nodeName    string         // Identifier for this node, the leases are released with it if it is given up
+	instanceID  string         // Identifier for this node process, the leases are released once the node restarts
+}
+
+func NewLeaser(leaseClient pb.LeaseClient, nodeName, instanceID string) core.Leaser {
+	return &leaser{
+		leaseClient: leaseClient,
+		nodeName:    nodeName,
+		instanceID:  instanceID,
+	}
+}
+
+// Acquire leases a free resource of a pool, waiting on the manager until one is released.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//   - pool: Name of the pool
+//
+// Returns:
+//   - core.Lease: The granted lease
+//   - error: core.ErrLeasePoolNotFound if the pool doesn't exist, the context error if it is done first
+func (l *leaser) Acquire(ctx context.Context, pool string) (core.Lease, error) {
+	grant, err := l.leaseClient.AcquireLease(ctx, &pb.LeaseAcquire{
+		NodeName:   l.nodeName,
+		Pool:       pool,
+		InstanceId: l.instanceID,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return core.Lease{}, ctx.Err()
+		}
+		if status.Code(err) == codes.NotFound {
+			return core.Lease{}, fmt.Errorf("%w: %s", core.ErrLeasePoolNotFound, pool)
+		}
+		return core.Lease{}, err
+	}
+
+	return core.Lease{
+		ID:       grant.Id,
+		Pool:     grant.Pool,
+		Resource: grant.Resource,
+		Node:     l.nodeName,
+		Instance: l.instanceID,
+	}, nil
+}
+
+// Release returns a leased resource to its pool.
+//
+// Parameters:
+//   - ctx: Context of the release
+//   - lease: The lease to release
+//
+// Returns:
+//   - error: core.ErrLeaseNotFound if the lease was already released
+func (l *leaser) Release(ctx context.Context, lease core.Lease) error {
+	_, err := l.leaseClient.ReleaseLease(ctx, &pb.LeaseGrant{
+		Id:       lease.ID,
+		Pool:     lease.Pool,
+		Resource: lease.Resource,
+	})
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%w: %s of pool %s", core.ErrLeaseNotFound, lease.Resource, lease.Pool)
+	}
+
+	return err
+}
@@ -1,31 +1,197 @@
 package handlers
 
 import (
+	"encoding/json"
 	"load-generation-system/internal/core"
 	"load-generation-system/internal/scenarios"
 	"load-generation-system/pkg/grpc/go/pb"
+	"log"
 )
 
 func (gateway *attackGateway) mapStartToCore(start *pb.OperationStart) core.OperationStart {
+	arrivalRates := gateway.mapArrivalRatesToCore(start.ArrivalRates)
+
+	pacings := make(map[string]core.Pacing, len(start.Pacings))
+	for scenario, pacing := range start.Pacings {
+		pacings[scenario] = core.Pacing{
+			Mode:        core.PacingMode(pacing.Mode),
+			IntervalSec: pacing.IntervalSec,
+			MinSec:      pacing.MinSec,
+			MaxSec:      pacing.MaxSec,
+		}
+	}
+
+	params := make(map[string]core.Params, len(start.Params))
+	for scenario, encoded := range start.Params {
+		var scenarioParams core.Params
+		if err := json.Unmarshal(encoded, &scenarioParams); err != nil {
+			log.Printf("bad parameters of scenario %s: %v", scenario, err)
+			continue
+		}
+		params[scenario] = scenarioParams
+	}
+
+	feeds := make([]core.FeedShare, 0, len(start.Feeds))
+	for _, share := range start.Feeds {
+		rows := make([]core.DataRow, 0, len(share.Rows))
+		for _, row := range share.Rows {
+			rows = append(rows, row.Values)
+		}
+		feeds = append(feeds, core.FeedShare{
+			Dataset: share.Dataset,
+			Mode:    core.FeedMode(share.Mode),
+			Rows:    rows,
+		})
+	}
+
 	return core.OperationStart{
-		ID:          start.Id,
-		AttackID:    start.AttackId,
-		IncrementID: start.IncrementId,
-		WaitTimeSec: float64(start.WaitTimeSec),
-		Scenarios:   start.Scenarios,
+		ID:                start.Id,
+		AttackID:          start.AttackId,
+		IncrementID:       start.IncrementId,
+		WaitTimeSec:       float64(start.WaitTimeSec),
+		Scenarios:         start.Scenarios,
+		ArrivalRates:      arrivalRates,
+		Pacings:           pacings,
+		Iterations:        start.Iterations,
+		IterationsPerUser: start.IterationsPerUser,
+		Target:            gateway.mapTargetToCore(start.Target),
+		Params:            params,
+		Feeds:             feeds,
+	}
+}
+
+func (gateway *attackGateway) mapTargetToCore(target *pb.Target) *core.Target {
+	if target == nil {
+		return nil
 	}
+
+	return &core.Target{
+		Name:     target.Name,
+		Services: target.Services,
+		Headers:  target.Headers,
+	}
+}
+
+func (gateway *attackGateway) mapArrivalRatesToCore(rates map[string]*pb.ArrivalRate) map[string]core.ArrivalRate {
+	arrivalRates := make(map[string]core.ArrivalRate, len(rates))
+	for scenario, rate := range rates {
+		arrivalRates[scenario] = core.ArrivalRate{
+			Rate:       rate.Rate,
+			TargetRate: rate.TargetRate,
+			RampUpSec:  rate.RampUpSec,
+		}
+	}
+
+	return arrivalRates
 }
 
 func (gateway *attackGateway) mapStopToCore(stop *pb.OperationStop) core.OperationStop {
 	return core.OperationStop{
-		AttackID:    stop.AttackId,
-		IncrementID: stop.IncrementId,
+		AttackID:        stop.AttackId,
+		IncrementID:     stop.IncrementId,
+		DrainTimeoutSec: stop.DrainTimeoutSec,
+	}
+}
+
+func (gateway *attackGateway) mapReduceToCore(reduce *pb.OperationReduce) core.OperationReduce {
+	return core.OperationReduce{
+		AttackID:    reduce.AttackId,
+		IncrementID: reduce.IncrementId,
+		Scenarios:   reduce.Scenarios,
+	}
+}
+
+func (gateway *attackGateway) mapRescaleToCore(rescale *pb.OperationRescale) core.OperationRescale {
+	return core.OperationRescale{
+		AttackID:     rescale.AttackId,
+		IncrementID:  rescale.IncrementId,
+		ArrivalRates: gateway.mapArrivalRatesToCore(rescale.ArrivalRates),
+	}
+}
+
+func (gateway *attackGateway) mapPauseToCore(pause *pb.OperationPause) core.OperationPause {
+	return core.OperationPause{
+		AttackID: pause.AttackId,
+	}
+}
+
+func (gateway *attackGateway) mapResumeToCore(resume *pb.OperationResume) core.OperationResume {
+	return core.OperationResume{
+		AttackID: resume.AttackId,
 	}
 }
 
 func (gateway *attackGateway) mapScenario(scenario scenarios.Scenario) *pb.Scenario {
+	parameters := make([]*pb.ScenarioParameter, 0, len(scenario.Parameters))
+	for _, parameter := range scenario.Parameters {
+		var defaultValue []byte
+		if parameter.Default != nil {
+			encoded, err := json.Marshal(parameter.Default)
+			if err != nil {
+				log.Printf("impossible to encode default of parameter %s of scenario %s: %v", parameter.Name, scenario.Name, err)
+			}
+			defaultValue = encoded
+		}
+
+		parameters = append(parameters, &pb.ScenarioParameter{
+			Name:        parameter.Name,
+			Type:        string(parameter.Type),
+			Description: parameter.Description,
+			Required:    parameter.Required,
+			Default:     defaultValue,
+		})
+	}
+
 	return &pb.Scenario{
 		Name:        scenario.Name,
 		Description: scenario.Description,
+		Parameters:  parameters,
+	}
+}
+
+func (gateway *attackGateway) mapReportFromCore(
+	stats []core.AttackStats,
+	completions []core.IncrementCompletion,
+) *pb.AttackRequest {
+	attackStats := make([]*pb.AttackStats, 0, len(stats))
+	for _, attack := range stats {
+		var checks map[string]*pb.CheckStats
+		if len(attack.Checks) != 0 {
+			checks = make(map[string]*pb.CheckStats, len(attack.Checks))
+			for name, check := range attack.Checks {
+				checks[name] = &pb.CheckStats{
+					Passed: check.Passed,
+					Failed: check.Failed,
+				}
+			}
+		}
+
+		attackStats = append(attackStats, &pb.AttackStats{
+			AttackId:      attack.AttackID,
+			Requests:      attack.Requests,
+			Errors:        attack.Errors,
+			Bounds:        attack.Bounds,
+			Buckets:       attack.Buckets,
+			Checks:        checks,
+			SetupFailures: attack.SetupFailures,
+		})
+	}
+
+	incrementCompletions := make([]*pb.IncrementCompletion, 0, len(completions))
+	for _, completion := range completions {
+		incrementCompletions = append(incrementCompletions, &pb.IncrementCompletion{
+			AttackId:    completion.AttackID,
+			IncrementId: completion.IncrementID,
+			Iterations:  completion.Iterations,
+		})
+	}
+
+	return &pb.AttackRequest{
+		Request: &pb.AttackRequest_Report{
+			Report: &pb.Report{
+				Stats:       attackStats,
+				Completions: incrementCompletions,
+			},
+		},
 	}
 }
@@ -4,20 +4,24 @@ import (
 	"context"
 	"io"
 	"load-generation-system/internal/core"
+	"load-generation-system/internal/metrics"
 	"load-generation-system/internal/scenarios"
 	"load-generation-system/internal/service/generator"
 	"load-generation-system/pkg/grpc/go/pb"
 	"log"
+	"time"
 )
 
 // attackGateway implements the core.AttackGateway interface and manages the communication
 // between the node and the central attack service via gRPC streaming.
-// It handles operation commands (start/stop/kill) and maintains the load generation state.
+// It handles operation commands (start/stop/reduce/rescale/kill), registers the uploaded scenarios and maintains the load generation state.
 type attackGateway struct {
 	attackClient  pb.AttackClient    // gRPC client for attack service communication
 	loadGenerator core.LoadGenerator // Load generator implementation for executing attacks
 
-	nodeName string // Identifier for this node
+	nodeName            string        // Identifier for this node
+	instanceID          string        // Identifier for this node process
+	statsReportInterval time.Duration // Interval between the attack statistics reports
 
 	sendCh chan *pb.AttackRequest  // Channel for outgoing messages to the attack service
 	recvCh chan *pb.AttackResponse // Channel for incoming messages from the attack service
@@ -26,16 +30,21 @@ type attackGateway struct {
 
 func NewGateway(
 	attackClient pb.AttackClient,
+	leaser core.Leaser,
+	setupCoordinator core.SetupCoordinator,
 	config generator.Config,
-	nodeName string,
+	nodeName, instanceID string,
+	statsReportIntervalSec int64,
 ) core.AttackGateway {
 	return &attackGateway{
-		attackClient:  attackClient,
-		loadGenerator: generator.New(config),
-		nodeName:      nodeName,
-		sendCh:        make(chan *pb.AttackRequest),
-		recvCh:        make(chan *pb.AttackResponse),
-		doneCh:        make(chan any),
+		attackClient:        attackClient,
+		loadGenerator:       generator.New(config, leaser, setupCoordinator),
+		nodeName:            nodeName,
+		instanceID:          instanceID,
+		statsReportInterval: time.Duration(statsReportIntervalSec) * time.Second,
+		sendCh:              make(chan *pb.AttackRequest),
+		recvCh:              make(chan *pb.AttackResponse),
+		doneCh:              make(chan any),
 	}
 }
 
@@ -60,10 +69,12 @@ func (g *attackGateway) Start(ctx context.Context) chan any {
 	go g.runSender(ctx, stream)
 	go g.runReceiver(ctx, stream)
 	go g.runHandler(ctx)
+	go g.runReporter(ctx)
 
 	// Prepare available scenarios information
-	scenariosInfo := make([]*pb.Scenario, 0, len(scenarios.AvailableScenarios))
-	for _, scenario := range scenarios.AvailableScenarios {
+	available := scenarios.List()
+	scenariosInfo := make([]*pb.Scenario, 0, len(available))
+	for _, scenario := range available {
 		scenariosInfo = append(scenariosInfo, g.mapScenario(scenario))
 	}
 
@@ -71,8 +82,9 @@ func (g *attackGateway) Start(ctx context.Context) chan any {
 	g.sendCh <- &pb.AttackRequest{
 		Request: &pb.AttackRequest_Handshake{
 			Handshake: &pb.Handshake{
-				NodeName:  g.nodeName,
-				Scenarios: scenariosInfo,
+				NodeName:   g.nodeName,
+				Scenarios:  scenariosInfo,
+				InstanceId: g.instanceID,
 			},
 		},
 	}
@@ -112,6 +124,16 @@ func (g *attackGateway) runHandler(ctx context.Context) {
 					request = g.handleStart(val.Start)
 				case *pb.AttackResponse_Stop:
 					request = g.handleStop(val.Stop)
+				case *pb.AttackResponse_Reduce:
+					request = g.handleReduce(val.Reduce)
+				case *pb.AttackResponse_Rescale:
+					request = g.handleRescale(val.Rescale)
+				case *pb.AttackResponse_Pause:
+					request = g.handlePause(val.Pause)
+				case *pb.AttackResponse_Resume:
+					request = g.handleResume(val.Resume)
+				case *pb.AttackResponse_Scenario:
+					request = g.handleScenario(val.Scenario)
 				case *pb.AttackResponse_Kill:
 					// Nil request signals the sender to close the stream
 					g.sendCh <- nil
@@ -165,6 +187,147 @@ func (g *attackGateway) handleStop(stop *pb.OperationStop) *pb.AttackRequest {
 	}
 }
 
+// handleReduce processes a reduce operation command from the attack service.
+//
+// Parameters:
+//   - reduce: The reduce operation details
+//
+// Returns:
+//   - *pb.AttackRequest: Acknowledgment to send back to the service
+func (g *attackGateway) handleReduce(reduce *pb.OperationReduce) *pb.AttackRequest {
+	attackReduce := g.mapReduceToCore(reduce)
+	if err := g.loadGenerator.ReduceAttack(attackReduce); err != nil {
+		log.Printf("failed to reduce attack: %v", err)
+	}
+
+	return &pb.AttackRequest{
+		Request: &pb.AttackRequest_Acknowledge{
+			Acknowledge: &pb.Acknowledge{},
+		},
+	}
+}
+
+// handleRescale processes a rescale operation command from the attack service.
+//
+// Parameters:
+//   - rescale: The rescale operation details
+//
+// Returns:
+//   - *pb.AttackRequest: Acknowledgment to send back to the service
+func (g *attackGateway) handleRescale(rescale *pb.OperationRescale) *pb.AttackRequest {
+	attackRescale := g.mapRescaleToCore(rescale)
+	if err := g.loadGenerator.RescaleAttack(attackRescale); err != nil {
+		log.Printf("failed to rescale attack: %v", err)
+	}
+
+	return &pb.AttackRequest{
+		Request: &pb.AttackRequest_Acknowledge{
+			Acknowledge: &pb.Acknowledge{},
+		},
+	}
+}
+
+// handlePause processes a pause operation command from the attack service.
+//
+// Parameters:
+//   - pause: The pause operation details
+//
+// Returns:
+//   - *pb.AttackRequest: Acknowledgment to send back to the service
+func (g *attackGateway) handlePause(pause *pb.OperationPause) *pb.AttackRequest {
+	attackPause := g.mapPauseToCore(pause)
+	if err := g.loadGenerator.PauseAttack(attackPause); err != nil {
+		log.Printf("failed to pause attack: %v", err)
+	}
+
+	return &pb.AttackRequest{
+		Request: &pb.AttackRequest_Acknowledge{
+			Acknowledge: &pb.Acknowledge{},
+		},
+	}
+}
+
+// handleResume processes a resume operation command from the attack service.
+//
+// Parameters:
+//   - resume: The resume operation details
+//
+// Returns:
+//   - *pb.AttackRequest: Acknowledgment to send back to the service
+func (g *attackGateway) handleResume(resume *pb.OperationResume) *pb.AttackRequest {
+	attackResume := g.mapResumeToCore(resume)
+	if err := g.loadGenerator.ResumeAttack(attackResume); err != nil {
+		log.Printf("failed to resume attack: %v", err)
+	}
+
+	return &pb.AttackRequest{
+		Request: &pb.AttackRequest_Acknowledge{
+			Acknowledge: &pb.Acknowledge{},
+		},
+	}
+}
+
+// handleScenario processes an uploaded scenario from the attack service and registers it on the node.
+//
+// Parameters:
+//   - scenario: The uploaded scenario details
+//
+// Returns:
+//   - *pb.AttackRequest: Acknowledgment to send back to the service
+func (g *attackGateway) handleScenario(scenario *pb.OperationScenario) *pb.AttackRequest {
+	definition, err := scenarios.ParseDefinition(scenario.Definition)
+	if err != nil {
+		log.Printf("failed to parse scenario %s version %d: %v", scenario.Name, scenario.Version, err)
+	} else if err := scenarios.Register(definition.Scenario()); err != nil {
+		log.Printf("failed to register scenario %s version %d: %v", scenario.Name, scenario.Version, err)
+	}
+
+	return &pb.AttackRequest{
+		Request: &pb.AttackRequest_Acknowledge{
+			Acknowledge: &pb.Acknowledge{},
+		},
+	}
+}
+
+// runReporter periodically sends the request statistics of the running attacks to the attack service.
+// Completions of the iteration-bounded increments are sent right away along with the statistics
+// collected so far, so that the service has the whole statistics of an attack once it ends.
+// It runs in a dedicated goroutine.
+//
+// Parameters:
+//   - ctx: Context for cancellation
+func (g *attackGateway) runReporter(ctx context.Context) {
+	ticker := time.NewTicker(g.statsReportInterval)
+	defer ticker.Stop()
+
+	for {
+		var completions []core.IncrementCompletion
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-g.doneCh:
+			return
+		case completion := <-g.loadGenerator.Completions():
+			completions = append(completions, completion)
+		case <-ticker.C:
+		}
+
+		stats := metrics.AttackStats.Flush()
+		if len(stats) == 0 && len(completions) == 0 {
+			continue
+		}
+
+		select {
+		case g.sendCh <- g.mapReportFromCore(stats, completions):
+		case <-ctx.Done():
+			return
+		case <-g.doneCh:
+			return
+		}
+	}
+}
+
 // runSender manages outgoing messages to the attack service via the gRPC stream.
 // It runs in a dedicated goroutine.
 //
@@ -11,6 +11,7 @@ import (
 	pb_manager "load-generation-system/pkg/grpc/go/pb"
 	"load-generation-system/pkg/rest"
 
+	"github.com/google/uuid"
 	"github.com/google/wire"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
@@ -21,7 +22,12 @@ import (
 // wire set for loading the node.
 var nodeSet = wire.NewSet( // nolint
 	provideManagerConnection,
+	provideNodeInstance,
 	provideManagerClient,
+	provideLeaseClient,
+	provideLeaser,
+	provideSetupClient,
+	provideSetupCoordinator,
 	provideNodeGRPCConnection,
 	provideNodeServerConfig,
 	restHandlers.NewResolver,
@@ -39,10 +45,14 @@ func provideNodeServerConfig(c *cli.Context) rest.Config {
 	return cfg
 }
 
+// maxOperationSize bounds the operations received from the manager, which carry the dataset rows of the node.
+const maxOperationSize = 64 * 1024 * 1024
+
 func provideManagerConnection(c *cli.Context) (api.ManagerConn, error) {
 	conn, err := grpc.NewClient(
 		c.String("grpc-manager-host"),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxOperationSize)),
 	)
 	if err != nil {
 		return api.ManagerConn{}, err
@@ -54,6 +64,27 @@ func provideManagerClient(conn api.ManagerConn) pb_manager.AttackClient {
 	return pb_manager.NewAttackClient(conn.Conn)
 }
 
+func provideLeaseClient(conn api.ManagerConn) pb_manager.LeaseClient {
+	return pb_manager.NewLeaseClient(conn.Conn)
+}
+
+// provideNodeInstance identifies the node process, so that the manager tells a restarted node from a reconnected one.
+func provideNodeInstance() api.NodeInstance {
+	return api.NodeInstance{ID: uuid.NewString()}
+}
+
+func provideLeaser(c *cli.Context, leaseClient pb_manager.LeaseClient, instance api.NodeInstance) core.Leaser {
+	return handlers.NewLeaser(leaseClient, c.String("node-name"), instance.ID)
+}
+
+func provideSetupClient(conn api.ManagerConn) pb_manager.SetupClient {
+	return pb_manager.NewSetupClient(conn.Conn)
+}
+
+func provideSetupCoordinator(c *cli.Context, setupClient pb_manager.SetupClient) core.SetupCoordinator {
+	return handlers.NewSetupCoordinator(setupClient, c.String("node-name"))
+}
+
 func provideNodeGRPCConnection(c *cli.Context) (api.GRPCConn, error) {
 	conn, err := grpc.NewClient(
 		c.String("grpc-host"),
@@ -71,12 +102,19 @@ func provideNodeGRPCConnection(c *cli.Context) (api.GRPCConn, error) {
 func provideAttackGateway(
 	c *cli.Context,
 	attackClient pb_manager.AttackClient,
+	leaser core.Leaser,
+	setupCoordinator core.SetupCoordinator,
 	config generator.Config,
+	instance api.NodeInstance,
 ) core.AttackGateway {
 	return handlers.NewGateway(
 		attackClient,
+		leaser,
+		setupCoordinator,
 		config,
 		c.String("node-name"),
+		instance.ID,
+		c.Int64("stats-report-interval-sec"),
 	)
 }
 
@@ -22,8 +22,13 @@ func InitializeNode(c *cli.Context, appCtx context.Context) (api.NodeContainer,
 		return api.NodeContainer{}, err
 	}
 	attackClient := provideManagerClient(managerConn)
+	leaseClient := provideLeaseClient(managerConn)
+	nodeInstance := provideNodeInstance()
+	leaser := provideLeaser(c, leaseClient, nodeInstance)
+	setupClient := provideSetupClient(managerConn)
+	setupCoordinator := provideSetupCoordinator(c, setupClient)
 	config := provideGeneratorConfig(c)
-	attackGateway := provideAttackGateway(c, attackClient, config)
+	attackGateway := provideAttackGateway(c, attackClient, leaser, setupCoordinator, config, nodeInstance)
 	restConfig := provideNodeServerConfig(c)
 	server := rest.New(restConfig)
 	resolver := handlers.NewResolver(server)
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/dataset"
+	"load-generation-system/pkg/web"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Title  Upload dataset
+// @Description  The body holds the rows of the dataset: a CSV file with a header row, or one JSON object per line. The dataset replaces the one with the same name, running attacks keep the rows they were started with.
+// @Param  name  path  string  true  "Dataset name"  "string"
+// @Param  format  query  string  true  "Dataset format: csv or jsonl"  "csv"
+// @Success  200  object  model.UploadDatasetResponse  "Successful dataset upload"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Dataset
+// @Router  /manager/api/v1/datasets/{name} [put]
+func (r *Resolver) uploadDataset(ctx *fiber.Ctx) error {
+	upload, err := dataset.ParseDataset(ctx.Params("name"), ctx.Query("format"), ctx.Body())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	datasetDetails, err := r.datasetService.UploadDataset(upload)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := dataset.PresentDataset(datasetDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get datasets
+// @Success  200  object  model.GetDatasetsResponse  "Successful get datasets"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Dataset
+// @Router  /manager/api/v1/datasets [get]
+func (r *Resolver) getDatasets(ctx *fiber.Ctx) error {
+	datasets := r.datasetService.GetDatasets()
+
+	pres := dataset.PresentDatasetList(datasets)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Delete dataset
+// @Description  Attacks fed from the dataset keep the rows they were started with.
+// @Param  name  path  string  true  "Dataset name"  "string"
+// @Success  200  object  model.DeleteDatasetResponse  "Successful dataset deletion"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Dataset
+// @Router  /manager/api/v1/datasets/{name} [delete]
+func (r *Resolver) deleteDataset(ctx *fiber.Ctx) error {
+	err := r.datasetService.DeleteDataset(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
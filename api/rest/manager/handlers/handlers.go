@@ -3,24 +3,47 @@ package handlers
 import (
 	"load-generation-system/api/rest/manager/handlers/model"
 	"load-generation-system/api/rest/manager/presenters/attack"
+	"load-generation-system/internal/core"
 	"load-generation-system/pkg/web"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 // @Title  Start new attack
+// @Description  Accepts either a JSON body or a multipart form with the JSON "config" field and the CSV "series" file of a trace attack. The "params" of a scenario are checked against the parameter schema it advertises in the scenarios list.
 // @Param  config  body  model.StartAttackRequestBody  true  "Attack configuration"
 // @Success  201  object  model.StartAttackResponse  "Successful attack start"
 // @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Target not found"
 // @Failure  422  object  model.ValidationResponse  "Validation error"
 // @Failure  500  object  model.InternalServerError  "Internal server error"
 // @Resource  Attack
 // @Router  /manager/api/v1/attacks [post]
 func (r *Resolver) startAttack(ctx *fiber.Ctx) error {
 	var presenter attack.StartAttackPresenter
-	status, errResp := r.bodyChecker(ctx, &presenter)
-	if errResp != nil {
-		return ctx.Status(status).JSON(errResp)
+	if strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		status, errResp := r.formChecker(ctx, "config", &presenter)
+		if errResp != nil {
+			return ctx.Status(status).JSON(errResp)
+		}
+
+		// The uploaded file holds the series of a trace attack
+		if presenter.TraceConfig == nil {
+			response, status := model.MapError(core.ErrBadConfig)
+			return ctx.Status(status).JSON(response)
+		}
+		series, err := readFormFile(ctx, "series")
+		if err != nil {
+			response, status := model.MapError(err)
+			return ctx.Status(status).JSON(response)
+		}
+		presenter.TraceConfig.Series = series
+	} else {
+		status, errResp := r.bodyChecker(ctx, &presenter)
+		if errResp != nil {
+			return ctx.Status(status).JSON(errResp)
+		}
 	}
 
 	start, err := presenter.ToCore()
@@ -42,10 +65,14 @@ func (r *Resolver) startAttack(ctx *fiber.Ctx) error {
 }
 
 // @Title  Stop attack
+// @Description  Stops the attack. In the graceful mode the users start no new iterations, but the running ones are given the drain timeout to finish.
 // @Param  attack_id  path  int64  true  "Attack id"  "1"
+// @Param  mode  query  string  false  "Stop mode: immediate (default) or graceful"  "graceful"
+// @Param  drain_timeout_sec  query  int64  false  "Drain timeout of the graceful mode, 30 by default"  "30"
 // @Success  200  object  model.StopAttackResponse  "Successful attack end"
 // @Failure  400  object  model.BadRequestError  "Bad request error"
 // @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
 // @Failure  500  object  model.InternalServerError  "Internal server error"
 // @Resource  Attack
 // @Router  /manager/api/v1/attacks/{attack_id} [delete]
@@ -56,7 +83,108 @@ func (r *Resolver) stopAttack(ctx *fiber.Ctx) error {
 		return ctx.Status(status).JSON(response)
 	}
 
-	err = r.attackService.StopAttack(id)
+	var presenter attack.StopPresenter
+	status, errResp := r.queryChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	options, err := presenter.ToCore()
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	err = r.attackService.StopAttack(id, options)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Scale attack
+// @Description  Sets new total counters of the attack scenarios. Users are added to or retired from the existing increments without restarting the others.
+// @Param  attack_id  path  int64  true  "Attack id"  "1"
+// @Param  config  body  model.ScaleRequestBody  true  "Total counters of the scenarios"
+// @Success  200  object  model.ScaleAttackResponse  "Successful attack scale"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Attack
+// @Router  /manager/api/v1/attacks/{attack_id} [patch]
+func (r *Resolver) scaleAttack(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "attack_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	var presenter attack.ScalePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	attackDetails, err := r.attackService.ScaleAttack(id, presenter.ToCore())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := attack.PresentAttack(attackDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Pause attack
+// @Description  Suspends the iterations of the attack on every node, keeping its users and increments. The attack timers are frozen while it is paused.
+// @Param  attack_id  path  int64  true  "Attack id"  "1"
+// @Success  200  object  model.PauseAttackResponse  "Successful attack pause"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  409  object  model.ConflictError  "Attack is already paused"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Attack
+// @Router  /manager/api/v1/attacks/{attack_id}/pause [post]
+func (r *Resolver) pauseAttack(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "attack_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	err = r.attackService.PauseAttack(id)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Resume attack
+// @Param  attack_id  path  int64  true  "Attack id"  "1"
+// @Success  200  object  model.ResumeAttackResponse  "Successful attack resume"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  409  object  model.ConflictError  "Attack is not paused"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Attack
+// @Router  /manager/api/v1/attacks/{attack_id}/resume [post]
+func (r *Resolver) resumeAttack(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "attack_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	err = r.attackService.ResumeAttack(id)
 	if err != nil {
 		response, status := model.MapError(err)
 		return ctx.Status(status).JSON(response)
@@ -67,6 +195,7 @@ func (r *Resolver) stopAttack(ctx *fiber.Ctx) error {
 }
 
 // @Title  Get attack scenarios
+// @Description  Lists the scenarios of the nodes with the schema of the parameters they accept.
 // @Success  200  object  model.GetScenariosResponse  "Successful get scenarios"
 // @Failure  500  object  model.InternalServerError  "Internal server error"
 // @Resource  Attack
@@ -80,6 +209,34 @@ func (r *Resolver) getScenarios(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusOK).JSON(resp)
 }
 
+// @Title  Upload scenario
+// @Description  Accepts a declarative HTTP scenario, validates it and registers it on every node. Uploading a scenario with the name of a previously uploaded one creates its next version, used by the users started afterwards.
+// @Param  config  body  object  true  "Scenario definition"
+// @Success  201  object  model.UploadScenarioResponse  "Successful scenario upload"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  409  object  model.ConflictError  "Conflict error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Attack
+// @Router  /manager/api/v1/scenarios [post]
+func (r *Resolver) uploadScenario(ctx *fiber.Ctx) error {
+	upload, err := attack.ScenarioUploadToCore(ctx.Body())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	scenarioDetails, err := r.attackService.UploadScenario(upload)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := attack.PresentScenario(scenarioDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
 // @Title  Get active attacks
 // @Success  200  object  model.GetAttacksResponse  "Successful get attacks"
 // @Failure  500  object  model.InternalServerError "Internal server error"
@@ -94,6 +251,35 @@ func (r *Resolver) getAttacks(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusOK).JSON(resp)
 }
 
+// @Title  Get attack summary
+// @Description  Returns the outcome of an ended attack, including the results of its checks and the failed setups. The summaries of the most recent ended attacks are kept.
+// @Param  attack_id  path  int64  true  "Attack id"  "1"
+// @Success  200  object  model.GetAttackSummaryResponse  "Successful get attack summary"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  409  object  model.ConflictError  "Attack is still running"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Attack
+// @Router  /manager/api/v1/attacks/{attack_id}/summary [get]
+func (r *Resolver) getAttackSummary(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "attack_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	summary, err := r.attackService.GetSummary(id)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := attack.PresentSummary(summary)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
 // @Title  Get nodes information
 // @Success  200  object  model.GetNodesResponse  "Successful get nodes"
 // @Failure  500  object  model.InternalServerError  "Internal server error"
@@ -109,6 +295,7 @@ func (r *Resolver) getNodes(ctx *fiber.Ctx) error {
 }
 
 // @Title  Start new increment
+// @Description  Starts a new increment of the attack. An increment with a ttl_sec is stopped by the manager once its time to live elapses, not counting the pauses of the attack.
 // @Param  attack_id  path  int64  true  "Attack id"  "1"
 // @Param  config  body  model.StartIncrementRequestBody  true  "Increment configuration"
 // @Success 201  object  model.StartIncrementResponse  "Successful increment start"
@@ -130,26 +317,30 @@ func (r *Resolver) startIncrement(ctx *fiber.Ctx) error {
 		return ctx.Status(status).JSON(errResp)
 	}
 
-	start := presenter.ToCore(id)
+	start, ttlSec := presenter.ToCore(id)
 
-	incrementDetails, err := r.attackService.StartIncrement(start)
+	incrementDetails, err := r.attackService.StartIncrement(start, ttlSec)
 	if err != nil {
 		response, status := model.MapError(err)
 		return ctx.Status(status).JSON(response)
 	}
 
-	pres := attack.PresentIncrement(incrementDetails)
+	pres := attack.PresentIncrement(incrementDetails, nil)
 
 	resp := web.OKResponse(pres)
 	return ctx.Status(fiber.StatusCreated).JSON(resp)
 }
 
 // @Title  Stop increment
+// @Description  Stops the increment. In the graceful mode the users start no new iterations, but the running ones are given the drain timeout to finish.
 // @Param  attack_id  path  int64  true  "Attack id"  "1"
 // @Param  increment_id  path  int64  true  "Increment id"  "1"
+// @Param  mode  query  string  false  "Stop mode: immediate (default) or graceful"  "graceful"
+// @Param  drain_timeout_sec  query  int64  false  "Drain timeout of the graceful mode, 30 by default"  "30"
 // @Success  200  object  model.StopIncrementResponse  "Successful increment stop"
 // @Failure  400  object  model.BadRequestError  "Bad request error"
 // @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
 // @Failure  500  object  model.InternalServerError  "Internal server error"
 // @Resource  Attack
 // @Router  /manager/api/v1/attacks/{attack_id}/increments/{increment_id} [delete]
@@ -166,7 +357,19 @@ func (r *Resolver) stopIncrement(ctx *fiber.Ctx) error {
 		return ctx.Status(status).JSON(response)
 	}
 
-	err = r.attackService.StopIncrement(attackID, incrementID)
+	var presenter attack.StopPresenter
+	status, errResp := r.queryChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	options, err := presenter.ToCore()
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	err = r.attackService.StopIncrement(attackID, incrementID, options)
 	if err != nil {
 		response, status := model.MapError(err)
 		return ctx.Status(status).JSON(response)
@@ -175,3 +378,46 @@ func (r *Resolver) stopIncrement(ctx *fiber.Ctx) error {
 	resp := web.OKResponse(nil)
 	return ctx.Status(fiber.StatusOK).JSON(resp)
 }
+
+// @Title  Scale increment
+// @Description  Sets new counters of the increment scenarios. Users are added to or retired from the increment without restarting the others.
+// @Param  attack_id  path  int64  true  "Attack id"  "1"
+// @Param  increment_id  path  int64  true  "Increment id"  "1"
+// @Param  config  body  model.ScaleRequestBody  true  "Counters of the scenarios"
+// @Success  200  object  model.ScaleIncrementResponse  "Successful increment scale"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Attack
+// @Router  /manager/api/v1/attacks/{attack_id}/increments/{increment_id} [patch]
+func (r *Resolver) scaleIncrement(ctx *fiber.Ctx) error {
+	attackID, err := parseInt64Param(ctx, "attack_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	incrementID, err := parseInt64Param(ctx, "increment_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	var presenter attack.ScalePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	incrementDetails, err := r.attackService.ScaleIncrement(attackID, incrementID, presenter.ToCore())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := attack.PresentIncrement(incrementDetails, nil)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
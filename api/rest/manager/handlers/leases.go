@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/lease"
+	"load-generation-system/pkg/web"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Title  Save lease pool
+// @Description  Creates the pool or replaces the resources of the one with the same name. Each resource, such as a test account, is leased to one user of the cluster at a time. Leases held on removed resources stay valid until they are released.
+// @Param  name  path  string  true  "Lease pool name"  "string"
+// @Param  config  body  model.SaveLeasePoolRequestBody  true  "Lease pool resources"
+// @Success  200  object  model.SaveLeasePoolResponse  "Successful lease pool save"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Lease
+// @Router  /manager/api/v1/lease-pools/{name} [put]
+func (r *Resolver) saveLeasePool(ctx *fiber.Ctx) error {
+	var presenter lease.SaveLeasePoolPresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	poolDetails, err := r.leaseService.SavePool(presenter.ToCore(ctx.Params("name")))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := lease.PresentLeasePool(poolDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get lease pools
+// @Success  200  object  model.GetLeasePoolsResponse  "Successful get lease pools"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Lease
+// @Router  /manager/api/v1/lease-pools [get]
+func (r *Resolver) getLeasePools(ctx *fiber.Ctx) error {
+	pools := r.leaseService.GetPools()
+
+	pres := lease.PresentLeasePoolList(pools)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get lease pool
+// @Description  Returns the pool along with its current leases and the number of users waiting for a resource.
+// @Param  name  path  string  true  "Lease pool name"  "string"
+// @Success  200  object  model.GetLeasePoolResponse  "Successful get lease pool"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Lease
+// @Router  /manager/api/v1/lease-pools/{name} [get]
+func (r *Resolver) getLeasePool(ctx *fiber.Ctx) error {
+	poolDetails, err := r.leaseService.GetPool(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := lease.PresentLeasePool(poolDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Delete lease pool
+// @Description  The users waiting for a resource of the pool give up, the ones holding a lease keep their resource.
+// @Param  name  path  string  true  "Lease pool name"  "string"
+// @Success  200  object  model.DeleteLeasePoolResponse  "Successful lease pool deletion"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Lease
+// @Router  /manager/api/v1/lease-pools/{name} [delete]
+func (r *Resolver) deleteLeasePool(ctx *fiber.Ctx) error {
+	err := r.leaseService.DeletePool(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
@@ -22,6 +22,12 @@ func MapError(err error) (resp web.Response, status int) {
 				Reason: err.Error(),
 			},
 		), fiber.StatusBadRequest
+	case errors.Is(err, ErrParseQuery):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusBadRequest
 	case errors.Is(err, ErrInvalidPathParam):
 		return web.ErrorResponse(
 			web.ErrorPayload{
@@ -40,6 +46,114 @@ func MapError(err error) (resp web.Response, status int) {
 				Reason: err.Error(),
 			},
 		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrScheduleNotFound):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrTemplateNotFound):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrTemplateExists):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrTemplateParameter):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusBadRequest
+	case errors.Is(err, core.ErrTargetNotFound):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrBadParams):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusBadRequest
+	case errors.Is(err, core.ErrDatasetNotFound):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrBadDataset):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusBadRequest
+	case errors.Is(err, core.ErrLeasePoolNotFound):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrBadLeasePool):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusBadRequest
+	case errors.Is(err, core.ErrSuiteNotFound):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusNotFound
+	case errors.Is(err, core.ErrSuiteEnded):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrAttackPaused):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrAttackNotPaused):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrAttackRunning):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrAttackBounded):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrScenarioExists):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusConflict
+	case errors.Is(err, core.ErrBadScenario):
+		return web.ErrorResponse(
+			web.ErrorPayload{
+				Reason: err.Error(),
+			},
+		), fiber.StatusBadRequest
 	case errors.Is(err, core.ErrScenarioNotFound):
 		return web.ErrorResponse(
 			web.ErrorPayload{
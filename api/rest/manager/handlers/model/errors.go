@@ -33,3 +33,7 @@ type BadRequestError struct {
 type NotFoundError struct {
 	Status string `json:"status" example:"ERROR"`
 }
+
+type ConflictError struct {
+	Status string `json:"status" example:"ERROR"`
+}
@@ -5,38 +5,158 @@ import (
 )
 
 type StartAttackRequestBody struct {
-	Name         string        `json:"name" example:"string" validate:"required"`
-	WaitTimeSec  float64       `json:"wait_time_sec" example:"1" validate:"min=0.1,max=30"`
-	DurationSec  *int64        `json:"duration_sec" example:"1" validate:"omitempty,min=1,max=2592000"`
-	ConstConfig  *ConstConfig  `json:"const_config"`
-	LinearConfig *LinearConfig `json:"linear_config"`
+	Name              string                    `json:"name" example:"string" validate:"required"`
+	WaitTimeSec       float64                   `json:"wait_time_sec" example:"1" validate:"min=0.1,max=30"`
+	DurationSec       *int64                    `json:"duration_sec" example:"1" validate:"omitempty,min=1,max=2592000"`
+	ConstConfig       *ConstConfig              `json:"const_config"`
+	LinearConfig      *LinearConfig             `json:"linear_config"`
+	ArrivalRateConfig *ArrivalRateConfig        `json:"arrival_rate_config"`
+	StagesConfig      *StagesConfig             `json:"stages_config"`
+	SpikeConfig       *SpikeConfig              `json:"spike_config"`
+	PeriodicConfig    *PeriodicConfig           `json:"periodic_config"`
+	TraceConfig       *TraceConfig              `json:"trace_config"`
+	AdaptiveConfig    *AdaptiveConfig           `json:"adaptive_config"`
+	Iterations        *int64                    `json:"iterations,omitempty" example:"1000" validate:"omitempty,min=1"`
+	IterationsPerUser *int64                    `json:"iterations_per_user,omitempty" example:"10" validate:"omitempty,min=1"`
+	Target            *string                   `json:"target,omitempty" example:"staging"`
+	Params            map[string]map[string]any `json:"params,omitempty"`
+	Feeds             []FeedConfig              `json:"feeds,omitempty" validate:"omitempty,dive"`
+}
+
+type FeedConfig struct {
+	Dataset string `json:"dataset" example:"accounts" validate:"required"`
+	Mode    string `json:"mode" example:"sequential_unique" validate:"required,oneof=sequential_unique circular random"`
 }
 
 type ConstConfig struct {
-	Scenarios map[string]int64 `json:"scenarios" validate:"required"`
+	Scenarios map[string]int64        `json:"scenarios" validate:"required"`
+	Pacing    map[string]PacingConfig `json:"pacing,omitempty" validate:"omitempty,dive"`
 }
 
 type LinearConfig struct {
-	WarmUpSec       *int64   `json:"warm_up_sec,omitempty" example:"1" validate:"omitempty,min=1"`
-	StartCounter    int64    `json:"start_counter" example:"1" validate:"min=1"`
-	EndCounter      int64    `json:"end_counter" example:"1" validate:"min=1"`
-	CounterStep     *int64   `json:"counter_step,omitempty" example:"1" validate:"omitempty,min=1"`
-	StepIntervalSec *int64   `json:"step_interval_sec,omitempty" example:"1" validate:"omitempty,min=1"`
+	WarmUpSec       *int64                  `json:"warm_up_sec,omitempty" example:"1" validate:"omitempty,min=1"`
+	StartCounter    int64                   `json:"start_counter" example:"1" validate:"min=1"`
+	EndCounter      int64                   `json:"end_counter" example:"1" validate:"min=1"`
+	CounterStep     *int64                  `json:"counter_step,omitempty" example:"1" validate:"omitempty,min=1"`
+	StepIntervalSec *int64                  `json:"step_interval_sec,omitempty" example:"1" validate:"omitempty,min=1"`
+	RampDownSec     *int64                  `json:"ramp_down_sec,omitempty" example:"1" validate:"omitempty,min=1"`
+	Scenarios       []string                `json:"scenarios" validate:"required"`
+	Pacing          map[string]PacingConfig `json:"pacing,omitempty" validate:"omitempty,dive"`
+}
+
+type PacingConfig struct {
+	Mode        string   `json:"mode" example:"fixed" validate:"required,oneof=fixed uniform_think exponential_think poisson"`
+	IntervalSec *float64 `json:"interval_sec,omitempty" example:"1" validate:"omitempty,gt=0"`
+	MinSec      *float64 `json:"min_sec,omitempty" example:"1" validate:"omitempty,min=0"`
+	MaxSec      *float64 `json:"max_sec,omitempty" example:"1" validate:"omitempty,gt=0"`
+}
+
+type ArrivalRateConfig struct {
+	RampUpSec *int64                  `json:"ramp_up_sec,omitempty" example:"1" validate:"omitempty,min=1"`
+	Scenarios map[string]RateScenario `json:"scenarios" validate:"required,dive"`
+}
+
+type RateScenario struct {
+	Rate       float64  `json:"rate" example:"1" validate:"gt=0"`
+	TargetRate *float64 `json:"target_rate,omitempty" example:"1" validate:"omitempty,gt=0"`
+	MaxUsers   int64    `json:"max_users" example:"1" validate:"min=1"`
+}
+
+type StagesConfig struct {
+	StartCounter int64    `json:"start_counter" example:"1" validate:"min=1"`
+	Stages       []Stage  `json:"stages" validate:"required,min=1,dive"`
+	Scenarios    []string `json:"scenarios" validate:"required"`
+}
+
+type Stage struct {
+	TargetCounter int64  `json:"target_counter" example:"1" validate:"min=0"`
+	DurationSec   int64  `json:"duration_sec" example:"1" validate:"min=1"`
+	Ramp          string `json:"ramp,omitempty" example:"linear" validate:"omitempty,oneof=linear step"`
+}
+
+type SpikeConfig struct {
+	BaselineCounter  int64    `json:"baseline_counter" example:"1" validate:"min=1"`
+	SpikeCounter     int64    `json:"spike_counter" example:"1" validate:"min=1"`
+	SpikeDurationSec int64    `json:"spike_duration_sec" example:"1" validate:"min=1"`
+	SpikeCount       int64    `json:"spike_count" example:"1" validate:"min=1"`
+	IntervalSec      int64    `json:"interval_sec" example:"1" validate:"min=1"`
+	Scenarios        []string `json:"scenarios" validate:"required"`
+}
+
+type PeriodicConfig struct {
+	MinCounter        int64     `json:"min_counter" example:"1" validate:"min=1"`
+	MaxCounter        int64     `json:"max_counter" example:"1" validate:"min=1"`
+	PeriodSec         int64     `json:"period_sec" example:"1" validate:"min=1"`
+	PhaseSec          int64     `json:"phase_sec,omitempty" example:"0" validate:"min=0"`
+	SampleIntervalSec int64     `json:"sample_interval_sec" example:"1" validate:"min=1"`
+	Points            []float64 `json:"points,omitempty" validate:"omitempty,min=2,dive,min=0,max=1"`
+	Scenarios         []string  `json:"scenarios" validate:"required"`
+}
+
+type TraceConfig struct {
+	Mode            string       `json:"mode" example:"users" validate:"oneof=users rps"`
+	TimeCompression float64      `json:"time_compression,omitempty" example:"1" validate:"omitempty,gt=0"`
+	MaxUsers        *int64       `json:"max_users,omitempty" example:"1" validate:"omitempty,min=1"`
+	Series          string       `json:"series,omitempty" example:"timestamp,string"`
+	Points          []TracePoint `json:"points,omitempty" validate:"omitempty,dive"`
+}
+
+type TracePoint struct {
+	OffsetSec float64            `json:"offset_sec" example:"0" validate:"min=0"`
+	Values    map[string]float64 `json:"values" validate:"required,dive,min=0"`
+}
+
+type AdaptiveConfig struct {
+	Mode            string   `json:"mode" example:"users" validate:"oneof=users rps"`
+	StartValue      int64    `json:"start_value" example:"1" validate:"min=1"`
+	StepValue       int64    `json:"step_value" example:"1" validate:"min=1"`
+	MaxValue        *int64   `json:"max_value,omitempty" example:"1" validate:"omitempty,min=1"`
+	Resolution      int64    `json:"resolution" example:"1" validate:"min=1"`
+	StepIntervalSec int64    `json:"step_interval_sec" example:"1" validate:"min=1"`
+	MaxP95Ms        float64  `json:"max_p95_ms" example:"1" validate:"gt=0"`
+	MaxErrorRate    float64  `json:"max_error_rate" example:"0.01" validate:"min=0,max=1"`
+	MaxUsers        *int64   `json:"max_users,omitempty" example:"1" validate:"omitempty,min=1"`
 	Scenarios       []string `json:"scenarios" validate:"required"`
 }
 
 type StartIncrementRequestBody struct {
 	Scenarios map[string]int64 `json:"scenarios"`
+	TTLSec    *int64           `json:"ttl_sec,omitempty" example:"60" validate:"omitempty,min=1,max=2592000"`
+}
+
+type ScaleRequestBody struct {
+	Scenarios map[string]int64 `json:"scenarios" validate:"required"`
+}
+
+type StopQuery struct {
+	Mode            string `query:"mode" validate:"omitempty,oneof=immediate graceful"`
+	DrainTimeoutSec *int64 `query:"drain_timeout_sec" validate:"omitempty,min=1,max=3600"`
 }
 
 type ScenarioInfo struct {
-	Name        string `json:"name" example:"string"`
-	Description string `json:"description" example:"string"`
+	Name        string                  `json:"name" example:"string"`
+	Description string                  `json:"description" example:"string"`
+	Version     int64                   `json:"version,omitempty" example:"1"`
+	Parameters  []ScenarioParameterInfo `json:"parameters,omitempty"`
+}
+
+type ScenarioParameterInfo struct {
+	Name        string `json:"name" example:"category"`
+	Type        string `json:"type" example:"string"`
+	Description string `json:"description,omitempty" example:"string"`
+	Required    bool   `json:"required" example:"false"`
+	Default     any    `json:"default,omitempty"`
 }
 
 type IncrementInfo struct {
-	ID        int64             `json:"id" example:"1"`
-	Scenarios []ScenarioCounter `json:"scenarios"`
+	ID                  int64             `json:"id" example:"1"`
+	Scenarios           []ScenarioCounter `json:"scenarios"`
+	ArrivalRates        []ScenarioRate    `json:"arrival_rates,omitempty"`
+	Iterations          *int64            `json:"iterations,omitempty" example:"1000"`
+	IterationsPerUser   int64             `json:"iterations_per_user,omitempty" example:"10"`
+	CompletedIterations int64             `json:"completed_iterations,omitempty" example:"1000"`
+	TTLSec              *int64            `json:"ttl_sec,omitempty" example:"60"`
+	TTLRemainingSec     *int64            `json:"ttl_remaining_sec,omitempty" example:"42"`
 }
 
 type ScenarioCounter struct {
@@ -44,15 +164,58 @@ type ScenarioCounter struct {
 	Counter  int64  `json:"counter" example:"1"`
 }
 
+type ScenarioRate struct {
+	Scenario   string  `json:"scenario" example:"string"`
+	Rate       float64 `json:"rate" example:"1"`
+	TargetRate float64 `json:"target_rate" example:"1"`
+	RampUpSec  int64   `json:"ramp_up_sec" example:"1"`
+}
+
+type SpikeInfo struct {
+	IncrementID int64      `json:"increment_id" example:"1"`
+	StartedAt   time.Time  `json:"started_at" example:"2024-09-02T13:54:00Z"`
+	EndedAt     *time.Time `json:"ended_at,omitempty" example:"2024-09-02T13:55:00Z"`
+}
+
+type ScheduledRunInfo struct {
+	ScheduleID int64 `json:"schedule_id" example:"1"`
+	Run        int64 `json:"run" example:"1"`
+}
+
+type TemplateRefInfo struct {
+	Name    string `json:"name" example:"string"`
+	Version int64  `json:"version" example:"1"`
+}
+
 type AttackInfo struct {
-	ID           int64           `json:"id" example:"1"`
-	Name         string          `json:"name" example:"string"`
-	WaitTimeSec  float64         `json:"wait_time_sec" example:"1"`
-	CreatedAt    time.Time       `json:"created_at" example:"2024-09-02T13:54:00Z"`
-	DurationSec  *int64          `json:"duration_sec,omitempty" example:"1"`
-	ConstConfig  *ConstConfig    `json:"const_config"`
-	LinearConfig *LinearConfig   `json:"linear_config"`
-	Increments   []IncrementInfo `json:"increments"`
+	ID                int64                     `json:"id" example:"1"`
+	Name              string                    `json:"name" example:"string"`
+	WaitTimeSec       float64                   `json:"wait_time_sec" example:"1"`
+	CreatedAt         time.Time                 `json:"created_at" example:"2024-09-02T13:54:00Z"`
+	DurationSec       *int64                    `json:"duration_sec,omitempty" example:"1"`
+	ConstConfig       *ConstConfig              `json:"const_config"`
+	LinearConfig      *LinearConfig             `json:"linear_config"`
+	ArrivalRateConfig *ArrivalRateConfig        `json:"arrival_rate_config"`
+	StagesConfig      *StagesConfig             `json:"stages_config"`
+	ActiveStage       *int                      `json:"active_stage,omitempty" example:"0"`
+	SpikeConfig       *SpikeConfig              `json:"spike_config"`
+	Spikes            []SpikeInfo               `json:"spikes,omitempty"`
+	PeriodicConfig    *PeriodicConfig           `json:"periodic_config"`
+	TargetCounter     *int64                    `json:"target_counter,omitempty" example:"1"`
+	TraceConfig       *TraceConfig              `json:"trace_config"`
+	ActivePoint       *int                      `json:"active_point,omitempty" example:"0"`
+	AdaptiveConfig    *AdaptiveConfig           `json:"adaptive_config"`
+	SustainableLoad   *int64                    `json:"sustainable_load,omitempty" example:"1"`
+	Converged         bool                      `json:"converged,omitempty" example:"true"`
+	ScheduledRun      *ScheduledRunInfo         `json:"scheduled_run,omitempty"`
+	Template          *TemplateRefInfo          `json:"template,omitempty"`
+	Iterations        *int64                    `json:"iterations,omitempty" example:"1000"`
+	IterationsPerUser *int64                    `json:"iterations_per_user,omitempty" example:"10"`
+	Target            *TargetInfo               `json:"target,omitempty"`
+	Params            map[string]map[string]any `json:"params,omitempty"`
+	Feeds             []FeedConfig              `json:"feeds,omitempty"`
+	Paused            bool                      `json:"paused" example:"false"`
+	Increments        []IncrementInfo           `json:"increments"`
 }
 
 type StartAttackResponse struct {
@@ -65,16 +228,36 @@ type StartIncrementResponse struct {
 	Attack IncrementInfo `json:"data"`
 }
 
+type ScaleAttackResponse struct {
+	Status string     `json:"status" example:"OK"`
+	Attack AttackInfo `json:"data"`
+}
+
+type ScaleIncrementResponse struct {
+	Status    string        `json:"status" example:"OK"`
+	Increment IncrementInfo `json:"data"`
+}
+
 type GetScenariosResponse struct {
 	Status    string         `json:"status" example:"OK"`
 	Scenarios []ScenarioInfo `json:"data"`
 }
 
+type UploadScenarioResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Scenario ScenarioInfo `json:"data"`
+}
+
 type GetAttacksResponse struct {
 	Status  string       `json:"status" example:"OK"`
 	Attacks []AttackInfo `json:"data"`
 }
 
+type GetAttackSummaryResponse struct {
+	Status  string            `json:"status" example:"OK"`
+	Summary AttackSummaryInfo `json:"data"`
+}
+
 type NodeInfo struct {
 	Name      string       `json:"name" example:"string"`
 	Scenarios []string     `json:"scenarios"`
@@ -95,4 +278,284 @@ type StopIncrementResponse struct {
 	Status string `json:"status" example:"OK"`
 }
 
+type PauseAttackResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type ResumeAttackResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type StartScheduleRequestBody struct {
+	Name    string                 `json:"name" example:"string" validate:"required"`
+	StartAt *time.Time             `json:"start_at,omitempty" example:"2024-09-02T13:54:00Z"`
+	Cron    *string                `json:"cron,omitempty" example:"0 3 * * *"`
+	Attack  StartAttackRequestBody `json:"attack"`
+}
+
+type ScheduleRunInfo struct {
+	Run       int64     `json:"run" example:"1"`
+	StartedAt time.Time `json:"started_at" example:"2024-09-02T13:54:00Z"`
+	AttackID  *int64    `json:"attack_id,omitempty" example:"1"`
+	Error     *string   `json:"error,omitempty" example:"string"`
+}
+
+type ScheduleInfo struct {
+	ID        int64                  `json:"id" example:"1"`
+	Name      string                 `json:"name" example:"string"`
+	StartAt   *time.Time             `json:"start_at,omitempty" example:"2024-09-02T13:54:00Z"`
+	Cron      *string                `json:"cron,omitempty" example:"0 3 * * *"`
+	Attack    StartAttackRequestBody `json:"attack"`
+	CreatedAt time.Time              `json:"created_at" example:"2024-09-02T13:54:00Z"`
+	NextRunAt *time.Time             `json:"next_run_at,omitempty" example:"2024-09-02T13:54:00Z"`
+	Runs      []ScheduleRunInfo      `json:"runs"`
+}
+
+type StartScheduleResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Schedule ScheduleInfo `json:"data"`
+}
+
+type UpdateScheduleResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Schedule ScheduleInfo `json:"data"`
+}
+
+type GetSchedulesResponse struct {
+	Status    string         `json:"status" example:"OK"`
+	Schedules []ScheduleInfo `json:"data"`
+}
+
+type CancelScheduleResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type TemplateParameter struct {
+	Name        string `json:"name" example:"duration" validate:"required"`
+	Description string `json:"description,omitempty" example:"string"`
+	Default     any    `json:"default,omitempty"`
+}
+
+// StartTemplateRequestBody is both the body of the template creation and the content of the exported template files.
+// The attack holds a StartAttackRequestBody where any value can be a "${name}" placeholder of a parameter.
+type StartTemplateRequestBody struct {
+	Name        string              `json:"name" example:"string" validate:"required"`
+	Description string              `json:"description,omitempty" example:"string"`
+	Parameters  []TemplateParameter `json:"parameters,omitempty" validate:"dive"`
+	Attack      map[string]any      `json:"attack" validate:"required"`
+}
+
+type UpdateTemplateRequestBody struct {
+	Description string              `json:"description,omitempty" example:"string"`
+	Parameters  []TemplateParameter `json:"parameters,omitempty" validate:"dive"`
+	Attack      map[string]any      `json:"attack" validate:"required"`
+}
+
+type RunTemplateRequestBody struct {
+	Version    *int64         `json:"version,omitempty" example:"1" validate:"omitempty,min=1"`
+	Parameters map[string]any `json:"parameters,omitempty"`
+	Overrides  map[string]any `json:"overrides,omitempty"`
+}
+
+type TemplateInfo struct {
+	Name        string              `json:"name" example:"string"`
+	Version     int64               `json:"version" example:"1"`
+	Description string              `json:"description,omitempty" example:"string"`
+	Parameters  []TemplateParameter `json:"parameters,omitempty"`
+	Attack      map[string]any      `json:"attack"`
+	CreatedAt   time.Time           `json:"created_at" example:"2024-09-02T13:54:00Z"`
+}
+
+type StartTemplateResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Template TemplateInfo `json:"data"`
+}
+
+type UpdateTemplateResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Template TemplateInfo `json:"data"`
+}
+
+type ImportTemplateResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Template TemplateInfo `json:"data"`
+}
+
+type GetTemplateResponse struct {
+	Status   string       `json:"status" example:"OK"`
+	Template TemplateInfo `json:"data"`
+}
+
+type GetTemplatesResponse struct {
+	Status    string         `json:"status" example:"OK"`
+	Templates []TemplateInfo `json:"data"`
+}
+
+type DeleteTemplateResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type RunTemplateResponse struct {
+	Status string     `json:"status" example:"OK"`
+	Attack AttackInfo `json:"data"`
+}
+
+type SaveTargetRequestBody struct {
+	Services map[string]string `json:"services" validate:"required,min=1,dive,url"`
+	Headers  map[string]string `json:"headers,omitempty"`
+}
+
+type TargetInfo struct {
+	Name      string            `json:"name" example:"staging"`
+	Services  map[string]string `json:"services"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	UpdatedAt *time.Time        `json:"updated_at,omitempty" example:"2024-09-02T13:54:00Z"`
+}
+
+type SaveTargetResponse struct {
+	Status string     `json:"status" example:"OK"`
+	Target TargetInfo `json:"data"`
+}
+
+type GetTargetResponse struct {
+	Status string     `json:"status" example:"OK"`
+	Target TargetInfo `json:"data"`
+}
+
+type GetTargetsResponse struct {
+	Status  string       `json:"status" example:"OK"`
+	Targets []TargetInfo `json:"data"`
+}
+
+type DeleteTargetResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type DatasetInfo struct {
+	Name       string    `json:"name" example:"accounts"`
+	Format     string    `json:"format" example:"csv"`
+	Columns    []string  `json:"columns"`
+	RowCount   int       `json:"row_count" example:"1000"`
+	UploadedAt time.Time `json:"uploaded_at" example:"2024-09-02T13:54:00Z"`
+}
+
+type UploadDatasetResponse struct {
+	Status  string      `json:"status" example:"OK"`
+	Dataset DatasetInfo `json:"data"`
+}
+
+type GetDatasetsResponse struct {
+	Status   string        `json:"status" example:"OK"`
+	Datasets []DatasetInfo `json:"data"`
+}
+
+type DeleteDatasetResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type SaveLeasePoolRequestBody struct {
+	Resources []string `json:"resources" validate:"required,min=1,unique,dive,required"`
+}
+
+type LeaseInfo struct {
+	Resource   string    `json:"resource" example:"user-001"`
+	Node       string    `json:"node" example:"node-1"`
+	AcquiredAt time.Time `json:"acquired_at" example:"2024-09-02T13:54:00Z"`
+}
+
+type LeasePoolInfo struct {
+	Name        string      `json:"name" example:"accounts"`
+	Resources   []string    `json:"resources"`
+	Leased      int         `json:"leased" example:"8"`
+	Waiting     int         `json:"waiting" example:"2"`
+	Utilization float64     `json:"utilization" example:"0.8"`
+	Leases      []LeaseInfo `json:"leases"`
+	UpdatedAt   time.Time   `json:"updated_at" example:"2024-09-02T13:54:00Z"`
+}
+
+type SaveLeasePoolResponse struct {
+	Status string        `json:"status" example:"OK"`
+	Pool   LeasePoolInfo `json:"data"`
+}
+
+type GetLeasePoolResponse struct {
+	Status string        `json:"status" example:"OK"`
+	Pool   LeasePoolInfo `json:"data"`
+}
+
+type GetLeasePoolsResponse struct {
+	Status string          `json:"status" example:"OK"`
+	Pools  []LeasePoolInfo `json:"data"`
+}
+
+type DeleteLeasePoolResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
+type SuiteGate struct {
+	MaxErrorRate *float64 `json:"max_error_rate,omitempty" example:"0.01" validate:"omitempty,min=0,max=1"`
+	MaxP95Ms     *float64 `json:"max_p95_ms,omitempty" example:"500" validate:"omitempty,gt=0"`
+	MinRequests  *int64   `json:"min_requests,omitempty" example:"1000" validate:"omitempty,min=0"`
+}
+
+type SuiteStep struct {
+	Name     string                 `json:"name" example:"smoke"`
+	DelaySec int64                  `json:"delay_sec,omitempty" example:"60" validate:"min=0"`
+	Gate     *SuiteGate             `json:"gate,omitempty"`
+	Attack   StartAttackRequestBody `json:"attack"`
+}
+
+type StartSuiteRequestBody struct {
+	Name  string      `json:"name" example:"string" validate:"required"`
+	Steps []SuiteStep `json:"steps" validate:"required,min=1,dive"`
+}
+
+type AttackSummaryInfo struct {
+	EndedAt       time.Time                 `json:"ended_at" example:"2024-09-02T13:54:00Z"`
+	Requests      int64                     `json:"requests" example:"1000"`
+	Errors        int64                     `json:"errors" example:"1"`
+	ErrorRate     float64                   `json:"error_rate" example:"0.001"`
+	P95Ms         float64                   `json:"p95_ms" example:"120"`
+	Checks        map[string]CheckStatsInfo `json:"checks,omitempty"`
+	SetupFailures int64                     `json:"setup_failures" example:"0"`
+}
+
+type CheckStatsInfo struct {
+	Passed int64 `json:"passed" example:"990"`
+	Failed int64 `json:"failed" example:"10"`
+}
+
+type SuiteStepResultInfo struct {
+	Step      int                `json:"step" example:"0"`
+	AttackID  *int64             `json:"attack_id,omitempty" example:"1"`
+	StartedAt *time.Time         `json:"started_at,omitempty" example:"2024-09-02T13:54:00Z"`
+	Summary   *AttackSummaryInfo `json:"summary,omitempty"`
+	Error     *string            `json:"error,omitempty" example:"string"`
+}
+
+type SuiteInfo struct {
+	ID         int64                 `json:"id" example:"1"`
+	Name       string                `json:"name" example:"string"`
+	Steps      []SuiteStep           `json:"steps"`
+	Status     string                `json:"status" example:"running"`
+	ActiveStep *int                  `json:"active_step,omitempty" example:"0"`
+	Results    []SuiteStepResultInfo `json:"results"`
+	CreatedAt  time.Time             `json:"created_at" example:"2024-09-02T13:54:00Z"`
+	EndedAt    *time.Time            `json:"ended_at,omitempty" example:"2024-09-02T13:54:00Z"`
+}
+
+type StartSuiteResponse struct {
+	Status string    `json:"status" example:"OK"`
+	Suite  SuiteInfo `json:"data"`
+}
+
+type GetSuitesResponse struct {
+	Status string      `json:"status" example:"OK"`
+	Suites []SuiteInfo `json:"data"`
+}
+
+type CancelSuiteResponse struct {
+	Status string `json:"status" example:"OK"`
+}
+
 type NoContentResponse struct{}
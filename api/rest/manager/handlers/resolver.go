@@ -8,9 +8,15 @@ import (
 )
 
 type Resolver struct {
-	server        rest.Server
-	attackService core.AttackService
-	validate      *validator.Validate
+	server          rest.Server
+	attackService   core.AttackService
+	scheduleService core.ScheduleService
+	templateService core.TemplateService
+	suiteService    core.SuiteService
+	targetService   core.TargetService
+	datasetService  core.DatasetService
+	leaseService    core.LeaseService
+	validate        *validator.Validate
 }
 
 const (
@@ -23,11 +29,23 @@ const (
 func NewResolver(
 	server rest.Server,
 	attackService core.AttackService,
+	scheduleService core.ScheduleService,
+	templateService core.TemplateService,
+	suiteService core.SuiteService,
+	targetService core.TargetService,
+	datasetService core.DatasetService,
+	leaseService core.LeaseService,
 ) *Resolver {
 	resolver := &Resolver{
-		server:        server,
-		attackService: attackService,
-		validate:      newValidate(),
+		server:          server,
+		attackService:   attackService,
+		scheduleService: scheduleService,
+		templateService: templateService,
+		suiteService:    suiteService,
+		targetService:   targetService,
+		datasetService:  datasetService,
+		leaseService:    leaseService,
+		validate:        newValidate(),
 	}
 
 	resolver.initRoutes()
@@ -39,8 +57,41 @@ func (r *Resolver) initRoutes() {
 	r.server.Router().Post(pathPrefix+"/attacks", r.startAttack)
 	r.server.Router().Post(pathPrefix+"/attacks/:attack_id/increments", r.startIncrement)
 	r.server.Router().Delete(pathPrefix+"/attacks/:attack_id", r.stopAttack)
+	r.server.Router().Patch(pathPrefix+"/attacks/:attack_id", r.scaleAttack)
+	r.server.Router().Post(pathPrefix+"/attacks/:attack_id/pause", r.pauseAttack)
+	r.server.Router().Post(pathPrefix+"/attacks/:attack_id/resume", r.resumeAttack)
 	r.server.Router().Delete(pathPrefix+"/attacks/:attack_id/increments/:increment_id", r.stopIncrement)
+	r.server.Router().Patch(pathPrefix+"/attacks/:attack_id/increments/:increment_id", r.scaleIncrement)
 	r.server.Router().Get(pathPrefix+"/scenarios", r.getScenarios)
+	r.server.Router().Post(pathPrefix+"/scenarios", r.uploadScenario)
 	r.server.Router().Get(pathPrefix+"/attacks", r.getAttacks)
+	r.server.Router().Get(pathPrefix+"/attacks/:attack_id/summary", r.getAttackSummary)
 	r.server.Router().Get(pathPrefix+"/nodes", r.getNodes)
+	r.server.Router().Post(pathPrefix+"/schedules", r.startSchedule)
+	r.server.Router().Put(pathPrefix+"/schedules/:schedule_id", r.updateSchedule)
+	r.server.Router().Delete(pathPrefix+"/schedules/:schedule_id", r.cancelSchedule)
+	r.server.Router().Get(pathPrefix+"/schedules", r.getSchedules)
+	r.server.Router().Post(pathPrefix+"/templates", r.startTemplate)
+	r.server.Router().Post(pathPrefix+"/templates/import", r.importTemplate)
+	r.server.Router().Get(pathPrefix+"/templates", r.getTemplates)
+	r.server.Router().Get(pathPrefix+"/templates/:name", r.getTemplate)
+	r.server.Router().Get(pathPrefix+"/templates/:name/versions", r.getTemplateVersions)
+	r.server.Router().Get(pathPrefix+"/templates/:name/export", r.exportTemplate)
+	r.server.Router().Put(pathPrefix+"/templates/:name", r.updateTemplate)
+	r.server.Router().Delete(pathPrefix+"/templates/:name", r.deleteTemplate)
+	r.server.Router().Post(pathPrefix+"/templates/:name/run", r.runTemplate)
+	r.server.Router().Put(pathPrefix+"/targets/:name", r.saveTarget)
+	r.server.Router().Get(pathPrefix+"/targets", r.getTargets)
+	r.server.Router().Get(pathPrefix+"/targets/:name", r.getTarget)
+	r.server.Router().Delete(pathPrefix+"/targets/:name", r.deleteTarget)
+	r.server.Router().Put(pathPrefix+"/datasets/:name", r.uploadDataset)
+	r.server.Router().Get(pathPrefix+"/datasets", r.getDatasets)
+	r.server.Router().Delete(pathPrefix+"/datasets/:name", r.deleteDataset)
+	r.server.Router().Put(pathPrefix+"/lease-pools/:name", r.saveLeasePool)
+	r.server.Router().Get(pathPrefix+"/lease-pools", r.getLeasePools)
+	r.server.Router().Get(pathPrefix+"/lease-pools/:name", r.getLeasePool)
+	r.server.Router().Delete(pathPrefix+"/lease-pools/:name", r.deleteLeasePool)
+	r.server.Router().Post(pathPrefix+"/suites", r.startSuite)
+	r.server.Router().Delete(pathPrefix+"/suites/:suite_id", r.cancelSuite)
+	r.server.Router().Get(pathPrefix+"/suites", r.getSuites)
 }
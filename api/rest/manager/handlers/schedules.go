@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/schedule"
+	"load-generation-system/pkg/web"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Title  Schedule attack
+// @Description  Schedules an attack either once at "start_at" or repeatedly on a five-field "cron" expression evaluated in UTC.
+// @Param  config  body  model.StartScheduleRequestBody  true  "Schedule configuration"
+// @Success  201  object  model.StartScheduleResponse  "Successful schedule creation"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Schedule
+// @Router  /manager/api/v1/schedules [post]
+func (r *Resolver) startSchedule(ctx *fiber.Ctx) error {
+	var presenter schedule.StartSchedulePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	start, err := presenter.ToCore()
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	scheduleDetails, err := r.scheduleService.CreateSchedule(start)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := schedule.PresentSchedule(scheduleDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// @Title  Update schedule
+// @Param  schedule_id  path  int64  true  "Schedule id"  "1"
+// @Param  config  body  model.StartScheduleRequestBody  true  "Schedule configuration"
+// @Success  200  object  model.UpdateScheduleResponse  "Successful schedule update"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Schedule
+// @Router  /manager/api/v1/schedules/{schedule_id} [put]
+func (r *Resolver) updateSchedule(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "schedule_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	var presenter schedule.StartSchedulePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	start, err := presenter.ToCore()
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	scheduleDetails, err := r.scheduleService.UpdateSchedule(id, start)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := schedule.PresentSchedule(scheduleDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Cancel schedule
+// @Param  schedule_id  path  int64  true  "Schedule id"  "1"
+// @Success  200  object  model.CancelScheduleResponse  "Successful schedule cancellation"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Schedule
+// @Router  /manager/api/v1/schedules/{schedule_id} [delete]
+func (r *Resolver) cancelSchedule(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "schedule_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	err = r.scheduleService.CancelSchedule(id)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get schedules
+// @Success  200  object  model.GetSchedulesResponse  "Successful get schedules"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Schedule
+// @Router  /manager/api/v1/schedules [get]
+func (r *Resolver) getSchedules(ctx *fiber.Ctx) error {
+	schedules := r.scheduleService.GetSchedules()
+
+	pres := schedule.PresentScheduleList(schedules)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
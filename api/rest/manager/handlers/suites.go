@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/suite"
+	"load-generation-system/pkg/web"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Title  Start suite
+// @Description  Runs the attacks of the steps one after another. A step starts once the previous attack has ended, its delay has elapsed and the outcome of the previous attack meets its gate.
+// @Param  config  body  model.StartSuiteRequestBody  true  "Suite configuration"
+// @Success  201  object  model.StartSuiteResponse  "Successful suite start"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Suite
+// @Router  /manager/api/v1/suites [post]
+func (r *Resolver) startSuite(ctx *fiber.Ctx) error {
+	var presenter suite.StartSuitePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	start, err := presenter.ToCore()
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	suiteDetails, err := r.suiteService.StartSuite(start)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := suite.PresentSuite(suiteDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// @Title  Cancel suite
+// @Description  Stops the attack of the active step and skips the remaining steps.
+// @Param  suite_id  path  int64  true  "Suite id"  "1"
+// @Success  200  object  model.CancelSuiteResponse  "Successful suite cancellation"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  409  object  model.ConflictError  "Suite has already ended"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Suite
+// @Router  /manager/api/v1/suites/{suite_id} [delete]
+func (r *Resolver) cancelSuite(ctx *fiber.Ctx) error {
+	id, err := parseInt64Param(ctx, "suite_id")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	err = r.suiteService.CancelSuite(id)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get suites
+// @Success  200  object  model.GetSuitesResponse  "Successful get suites"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Suite
+// @Router  /manager/api/v1/suites [get]
+func (r *Resolver) getSuites(ctx *fiber.Ctx) error {
+	suites := r.suiteService.GetSuites()
+
+	pres := suite.PresentSuiteList(suites)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
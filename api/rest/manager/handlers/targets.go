@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/target"
+	"load-generation-system/pkg/web"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Title  Save target
+// @Description  Creates the target or replaces the one with the same name. Services map service names, such as "test", to their base URLs. Running attacks keep the target they were started with.
+// @Param  name  path  string  true  "Target name"  "string"
+// @Param  config  body  model.SaveTargetRequestBody  true  "Target configuration"
+// @Success  200  object  model.SaveTargetResponse  "Successful target save"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Target
+// @Router  /manager/api/v1/targets/{name} [put]
+func (r *Resolver) saveTarget(ctx *fiber.Ctx) error {
+	var presenter target.SaveTargetPresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	targetDetails, err := r.targetService.SaveTarget(presenter.ToCore(ctx.Params("name")))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := target.PresentTarget(targetDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get targets
+// @Success  200  object  model.GetTargetsResponse  "Successful get targets"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Target
+// @Router  /manager/api/v1/targets [get]
+func (r *Resolver) getTargets(ctx *fiber.Ctx) error {
+	targets := r.targetService.GetTargets()
+
+	pres := target.PresentTargetList(targets)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get target
+// @Param  name  path  string  true  "Target name"  "string"
+// @Success  200  object  model.GetTargetResponse  "Successful get target"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Target
+// @Router  /manager/api/v1/targets/{name} [get]
+func (r *Resolver) getTarget(ctx *fiber.Ctx) error {
+	targetDetails, err := r.targetService.GetTarget(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := target.PresentTarget(targetDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Delete target
+// @Description  Attacks started against the target keep running against it.
+// @Param  name  path  string  true  "Target name"  "string"
+// @Success  200  object  model.DeleteTargetResponse  "Successful target deletion"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Target
+// @Router  /manager/api/v1/targets/{name} [delete]
+func (r *Resolver) deleteTarget(ctx *fiber.Ctx) error {
+	err := r.targetService.DeleteTarget(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
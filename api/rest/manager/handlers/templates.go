@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"encoding/json"
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/attack"
+	"load-generation-system/api/rest/manager/presenters/template"
+	"load-generation-system/internal/core"
+	"load-generation-system/pkg/web"
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// @Title  Create template
+// @Description  The attack of the template is a StartAttackRequestBody where any value can be a "${name}" placeholder of a declared parameter.
+// @Param  config  body  model.StartTemplateRequestBody  true  "Template configuration"
+// @Success  201  object  model.StartTemplateResponse  "Successful template creation"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  409  object  model.ConflictError  "Template already exists"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates [post]
+func (r *Resolver) startTemplate(ctx *fiber.Ctx) error {
+	var presenter template.StartTemplatePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	templateDetails, err := r.templateService.CreateTemplate(presenter.ToCore())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := template.PresentTemplate(templateDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// @Title  Update template
+// @Description  Adds a new version to the template, the previous versions are kept.
+// @Param  name  path  string  true  "Template name"  "string"
+// @Param  config  body  model.UpdateTemplateRequestBody  true  "Template configuration"
+// @Success  200  object  model.UpdateTemplateResponse  "Successful template update"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/{name} [put]
+func (r *Resolver) updateTemplate(ctx *fiber.Ctx) error {
+	var presenter template.UpdateTemplatePresenter
+	status, errResp := r.bodyChecker(ctx, &presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	templateDetails, err := r.templateService.UpdateTemplate(ctx.Params("name"), presenter.ToCore())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := template.PresentTemplate(templateDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Delete template
+// @Description  Deletes all versions of the template. Attacks launched from it keep running.
+// @Param  name  path  string  true  "Template name"  "string"
+// @Success  200  object  model.DeleteTemplateResponse  "Successful template deletion"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/{name} [delete]
+func (r *Resolver) deleteTemplate(ctx *fiber.Ctx) error {
+	err := r.templateService.DeleteTemplate(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	resp := web.OKResponse(nil)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get templates
+// @Description  Returns the latest version of every template.
+// @Success  200  object  model.GetTemplatesResponse  "Successful get templates"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates [get]
+func (r *Resolver) getTemplates(ctx *fiber.Ctx) error {
+	templates := r.templateService.GetTemplates()
+
+	pres := template.PresentTemplateList(templates)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get template
+// @Param  name  path  string  true  "Template name"  "string"
+// @Param  version  query  int64  false  "Template version, the latest one by default"  "1"
+// @Success  200  object  model.GetTemplateResponse  "Successful get template"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/{name} [get]
+func (r *Resolver) getTemplate(ctx *fiber.Ctx) error {
+	version, err := parseOptionalInt64Query(ctx, "version")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	templateDetails, err := r.templateService.GetTemplate(ctx.Params("name"), version)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := template.PresentTemplate(templateDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Get template versions
+// @Param  name  path  string  true  "Template name"  "string"
+// @Success  200  object  model.GetTemplatesResponse  "Successful get template versions"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/{name}/versions [get]
+func (r *Resolver) getTemplateVersions(ctx *fiber.Ctx) error {
+	versions, err := r.templateService.GetTemplateVersions(ctx.Params("name"))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := template.PresentTemplateList(versions)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// @Title  Run template
+// @Description  Starts an attack from a template version. The parameters override the defaults of the template and the overrides are applied to the rendered attack as a JSON merge patch.
+// @Param  name  path  string  true  "Template name"  "string"
+// @Param  config  body  model.RunTemplateRequestBody  true  "Run configuration"
+// @Success  201  object  model.RunTemplateResponse  "Successful attack start"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/{name}/run [post]
+func (r *Resolver) runTemplate(ctx *fiber.Ctx) error {
+	var runPresenter template.RunTemplatePresenter
+	status, errResp := r.bodyChecker(ctx, &runPresenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	rendered, ref, err := r.templateService.RenderTemplate(runPresenter.ToCore(ctx.Params("name")))
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	// The rendered attack goes through the same checks as the body of the start endpoint
+	var presenter attack.StartAttackPresenter
+	if err := remarshal(rendered, &presenter); err != nil {
+		log.Printf("%s: %s - %s", core.ErrTemplateParameter.Error(), ref.Name, err.Error())
+		response, status := model.MapError(core.ErrTemplateParameter)
+		return ctx.Status(status).JSON(response)
+	}
+	status, errResp = r.validateStruct(&presenter)
+	if errResp != nil {
+		return ctx.Status(status).JSON(errResp)
+	}
+
+	start, err := presenter.ToCore()
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+	start.Template = &ref
+
+	attackDetails, err := r.attackService.StartAttack(start)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := attack.PresentAttack(attackDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// @Title  Export template
+// @Description  Downloads a template version as a JSON file accepted by the import endpoint.
+// @Param  name  path  string  true  "Template name"  "string"
+// @Param  version  query  int64  false  "Template version, the latest one by default"  "1"
+// @Success  200  object  model.StartTemplateRequestBody  "Template file"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  404  object  model.NotFoundError  "Not found error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/{name}/export [get]
+func (r *Resolver) exportTemplate(ctx *fiber.Ctx) error {
+	version, err := parseOptionalInt64Query(ctx, "version")
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	templateDetails, err := r.templateService.GetTemplate(ctx.Params("name"), version)
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	ctx.Attachment(templateDetails.Name + ".json")
+	return ctx.Status(fiber.StatusOK).JSON(template.PresentTemplateFile(templateDetails))
+}
+
+// @Title  Import template
+// @Description  Accepts either a JSON body or a multipart form with the "template" file produced by the export endpoint. An existing template gets a new version.
+// @Param  config  body  model.StartTemplateRequestBody  true  "Template file"
+// @Success  201  object  model.ImportTemplateResponse  "Successful template import"
+// @Failure  400  object  model.BadRequestError  "Bad request error"
+// @Failure  422  object  model.ValidationResponse  "Validation error"
+// @Failure  500  object  model.InternalServerError  "Internal server error"
+// @Resource  Template
+// @Router  /manager/api/v1/templates/import [post]
+func (r *Resolver) importTemplate(ctx *fiber.Ctx) error {
+	var presenter template.StartTemplatePresenter
+	if strings.HasPrefix(ctx.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		content, err := readFormFile(ctx, "template")
+		if err != nil {
+			response, status := model.MapError(err)
+			return ctx.Status(status).JSON(response)
+		}
+		if err := json.Unmarshal([]byte(content), &presenter); err != nil {
+			log.Println(model.ErrParseBody.Error(), "error", err.Error())
+			response, status := model.MapError(model.ErrParseBody)
+			return ctx.Status(status).JSON(response)
+		}
+		status, errResp := r.validateStruct(&presenter)
+		if errResp != nil {
+			return ctx.Status(status).JSON(errResp)
+		}
+	} else {
+		status, errResp := r.bodyChecker(ctx, &presenter)
+		if errResp != nil {
+			return ctx.Status(status).JSON(errResp)
+		}
+	}
+
+	templateDetails, err := r.templateService.ImportTemplate(presenter.ToCore())
+	if err != nil {
+		response, status := model.MapError(err)
+		return ctx.Status(status).JSON(response)
+	}
+
+	pres := template.PresentTemplate(templateDetails)
+
+	resp := web.OKResponse(pres)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+// remarshal converts a decoded JSON value into the given entity.
+func remarshal(value any, entity any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(content, entity)
+}
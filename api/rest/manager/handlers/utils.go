@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"io"
 	"load-generation-system/api/rest/manager/handlers/model"
 	"log"
 	"strconv"
@@ -17,3 +18,40 @@ func parseInt64Param(ctx *fiber.Ctx, param string) (int64, error) {
 	}
 	return id, nil
 }
+
+func parseOptionalInt64Query(ctx *fiber.Ctx, query string) (*int64, error) {
+	valueStr := ctx.Query(query)
+	if valueStr == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil {
+		log.Printf("%s: %s - %s", model.ErrParseQuery.Error(), query, err.Error())
+		return nil, model.ErrParseQuery
+	}
+	return &value, nil
+}
+
+func readFormFile(ctx *fiber.Ctx, field string) (string, error) {
+	header, err := ctx.FormFile(field)
+	if err != nil {
+		log.Printf("%s: %s - %s", model.ErrParseBody.Error(), field, err.Error())
+		return "", model.ErrParseBody
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		log.Printf("%s: %s - %s", model.ErrParseBody.Error(), field, err.Error())
+		return "", model.ErrParseBody
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		log.Printf("%s: %s - %s", model.ErrParseBody.Error(), field, err.Error())
+		return "", model.ErrParseBody
+	}
+
+	return string(content), nil
+}
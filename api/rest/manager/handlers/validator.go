@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	"load-generation-system/api/rest/manager/handlers/model"
 	"load-generation-system/pkg/web"
@@ -42,3 +43,23 @@ func (r *Resolver) bodyChecker(ctx *fiber.Ctx, entity any) (int, *web.Response)
 
 	return r.validateStruct(entity)
 }
+
+func (r *Resolver) queryChecker(ctx *fiber.Ctx, entity any) (int, *web.Response) {
+	if err := ctx.QueryParser(entity); err != nil {
+		log.Println(model.ErrParseQuery.Error(), "error", err.Error())
+		response, status := model.MapError(model.ErrParseQuery)
+		return status, &response
+	}
+
+	return r.validateStruct(entity)
+}
+
+func (r *Resolver) formChecker(ctx *fiber.Ctx, field string, entity any) (int, *web.Response) {
+	if err := json.Unmarshal([]byte(ctx.FormValue(field)), entity); err != nil {
+		log.Println(model.ErrParseBody.Error(), "error", err.Error())
+		response, status := model.MapError(model.ErrParseBody)
+		return status, &response
+	}
+
+	return r.validateStruct(entity)
+}
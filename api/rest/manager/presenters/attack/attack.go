@@ -1,24 +1,69 @@
 package attack
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"load-generation-system/api/rest/manager/handlers/model"
 	"load-generation-system/internal/core"
+	"load-generation-system/internal/scenarios"
+	"maps"
+	"math"
 	"slices"
 	"sort"
+	"time"
 )
 
 type StartAttackPresenter model.StartAttackRequestBody
 
 type StartIncrementPresenter model.StartIncrementRequestBody
 
+type ScalePresenter model.ScaleRequestBody
+
+type StopPresenter model.StopQuery
+
+// defaultDrainTimeoutSec is the time the running iterations are given to finish
+// when a graceful stop does not set it.
+const defaultDrainTimeoutSec = 30
+
 func PresentScenario(scenario core.ScenarioDetails) model.ScenarioInfo {
 	return model.ScenarioInfo{
 		Name:        scenario.Name,
 		Description: scenario.Description,
+		Version:     scenario.Version,
+		Parameters:  presentParameters(scenario.Parameters),
+	}
+}
+
+// ScenarioUploadToCore validates an uploaded declarative scenario and converts it to its upload operation.
+//
+// Parameters:
+//   - body: JSON definition of the scenario
+//
+// Returns:
+//   - core.OperationScenario: The scenario to distribute, without its version
+//   - error: core.ErrBadScenario if the definition is invalid
+func ScenarioUploadToCore(body []byte) (core.OperationScenario, error) {
+	definition, err := scenarios.ParseDefinition(body)
+	if err != nil {
+		return core.OperationScenario{}, err
+	}
+
+	// The compacted copy no longer depends on the request buffer
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, body); err != nil {
+		return core.OperationScenario{}, fmt.Errorf("%w: %v", core.ErrBadScenario, err)
 	}
+
+	return core.OperationScenario{
+		Name:        definition.Name,
+		Description: definition.Description,
+		Parameters:  definition.ParameterSchema(),
+		Definition:  compacted.Bytes(),
+	}, nil
 }
 
-func PresentIncrement(increment core.IncrementDetails) model.IncrementInfo {
+func PresentIncrement(increment core.IncrementDetails, pausedAt *time.Time) model.IncrementInfo {
 	scenarioCounters := make([]model.ScenarioCounter, 0, len(increment.Scenarios))
 	for scenario, counter := range increment.Scenarios {
 		scenarioCounters = append(scenarioCounters, model.ScenarioCounter{
@@ -30,16 +75,58 @@ func PresentIncrement(increment core.IncrementDetails) model.IncrementInfo {
 		return scenarioCounters[i].Scenario < scenarioCounters[j].Scenario
 	})
 
+	var scenarioRates []model.ScenarioRate
+	for scenario, rate := range increment.ArrivalRates {
+		scenarioRates = append(scenarioRates, model.ScenarioRate{
+			Scenario:   scenario,
+			Rate:       rate.Rate,
+			TargetRate: rate.TargetRate,
+			RampUpSec:  rate.RampUpSec,
+		})
+	}
+	sort.Slice(scenarioRates, func(i, j int) bool {
+		return scenarioRates[i].Scenario < scenarioRates[j].Scenario
+	})
+
 	return model.IncrementInfo{
-		ID:        increment.ID,
-		Scenarios: scenarioCounters,
+		ID:                  increment.ID,
+		Scenarios:           scenarioCounters,
+		ArrivalRates:        scenarioRates,
+		Iterations:          increment.Iterations,
+		IterationsPerUser:   increment.IterationsPerUser,
+		CompletedIterations: increment.CompletedIterations,
+		TTLSec:              increment.TTLSec,
+		TTLRemainingSec:     remainingTTL(increment.ExpiresAt, pausedAt),
 	}
 }
 
+// remainingTTL computes the whole seconds left before the time to live of an increment elapses.
+// The countdown of a paused attack is frozen at the moment of the pause.
+//
+// Parameters:
+//   - expiresAt: Expiry of the increment, nil if it has no time to live
+//   - pausedAt: Time the attack was paused at, nil if it runs
+//
+// Returns:
+//   - *int64: Remaining seconds, nil if the increment has no time to live
+func remainingTTL(expiresAt, pausedAt *time.Time) *int64 {
+	if expiresAt == nil {
+		return nil
+	}
+
+	now := time.Now()
+	if pausedAt != nil {
+		now = *pausedAt
+	}
+	remaining := int64(max(math.Ceil(expiresAt.Sub(now).Seconds()), 0))
+
+	return &remaining
+}
+
 func PresentAttack(attack core.AttackDetails) model.AttackInfo {
 	incrementInfos := make([]model.IncrementInfo, 0, len(attack.Increments))
 	for _, increment := range attack.Increments {
-		incrementInfos = append(incrementInfos, PresentIncrement(increment))
+		incrementInfos = append(incrementInfos, PresentIncrement(increment, attack.PausedAt))
 	}
 	sort.Slice(incrementInfos, func(i, j int) bool {
 		return incrementInfos[i].ID < incrementInfos[j].ID
@@ -48,19 +135,34 @@ func PresentAttack(attack core.AttackDetails) model.AttackInfo {
 	var constConfig *model.ConstConfig
 	if attack.ConstConfig != nil {
 		scenarios := make(map[string]int64)
+		var pacings map[string]model.PacingConfig
 		for _, scenario := range attack.ConstConfig.Scenarios {
 			scenarios[scenario.Name] = *scenario.Counter
+			if scenario.Pacing != nil {
+				if pacings == nil {
+					pacings = make(map[string]model.PacingConfig)
+				}
+				pacings[scenario.Name] = presentPacing(*scenario.Pacing)
+			}
 		}
 		constConfig = &model.ConstConfig{
 			Scenarios: scenarios,
+			Pacing:    pacings,
 		}
 	}
 
 	var linearConfig *model.LinearConfig
 	if attack.LinearConfig != nil {
 		scenarios := make([]string, 0, len(attack.LinearConfig.Scenarios))
+		var pacings map[string]model.PacingConfig
 		for _, scenario := range attack.LinearConfig.Scenarios {
 			scenarios = append(scenarios, scenario.Name)
+			if scenario.Pacing != nil {
+				if pacings == nil {
+					pacings = make(map[string]model.PacingConfig)
+				}
+				pacings[scenario.Name] = presentPacing(*scenario.Pacing)
+			}
 		}
 
 		linearConfig = &model.LinearConfig{
@@ -69,19 +171,247 @@ func PresentAttack(attack core.AttackDetails) model.AttackInfo {
 			EndCounter:      attack.LinearConfig.EndCounter,
 			CounterStep:     attack.LinearConfig.CounterStep,
 			StepIntervalSec: attack.LinearConfig.StepIntervalSec,
+			RampDownSec:     attack.LinearConfig.RampDownSec,
 			Scenarios:       scenarios,
+			Pacing:          pacings,
+		}
+	}
+
+	var arrivalRateConfig *model.ArrivalRateConfig
+	if attack.ArrivalRateConfig != nil {
+		scenarios := make(map[string]model.RateScenario)
+		for _, scenario := range attack.ArrivalRateConfig.Scenarios {
+			scenarios[scenario.Name] = model.RateScenario{
+				Rate:       scenario.Rate,
+				TargetRate: scenario.TargetRate,
+				MaxUsers:   scenario.MaxUsers,
+			}
+		}
+
+		arrivalRateConfig = &model.ArrivalRateConfig{
+			RampUpSec: attack.ArrivalRateConfig.RampUpSec,
+			Scenarios: scenarios,
+		}
+	}
+
+	var stagesConfig *model.StagesConfig
+	if attack.StagesConfig != nil {
+		scenarios := make([]string, 0, len(attack.StagesConfig.Scenarios))
+		for _, scenario := range attack.StagesConfig.Scenarios {
+			scenarios = append(scenarios, scenario.Name)
+		}
+
+		stages := make([]model.Stage, 0, len(attack.StagesConfig.Stages))
+		for _, stage := range attack.StagesConfig.Stages {
+			stages = append(stages, model.Stage{
+				TargetCounter: stage.TargetCounter,
+				DurationSec:   stage.DurationSec,
+				Ramp:          string(stage.Ramp),
+			})
+		}
+
+		stagesConfig = &model.StagesConfig{
+			StartCounter: attack.StagesConfig.StartCounter,
+			Stages:       stages,
+			Scenarios:    scenarios,
+		}
+	}
+
+	var spikeConfig *model.SpikeConfig
+	if attack.SpikeConfig != nil {
+		scenarios := make([]string, 0, len(attack.SpikeConfig.Scenarios))
+		for _, scenario := range attack.SpikeConfig.Scenarios {
+			scenarios = append(scenarios, scenario.Name)
+		}
+
+		spikeConfig = &model.SpikeConfig{
+			BaselineCounter:  attack.SpikeConfig.BaselineCounter,
+			SpikeCounter:     attack.SpikeConfig.SpikeCounter,
+			SpikeDurationSec: attack.SpikeConfig.SpikeDurationSec,
+			SpikeCount:       attack.SpikeConfig.SpikeCount,
+			IntervalSec:      attack.SpikeConfig.IntervalSec,
+			Scenarios:        scenarios,
+		}
+	}
+
+	var periodicConfig *model.PeriodicConfig
+	if attack.PeriodicConfig != nil {
+		scenarios := make([]string, 0, len(attack.PeriodicConfig.Scenarios))
+		for _, scenario := range attack.PeriodicConfig.Scenarios {
+			scenarios = append(scenarios, scenario.Name)
+		}
+
+		periodicConfig = &model.PeriodicConfig{
+			MinCounter:        attack.PeriodicConfig.MinCounter,
+			MaxCounter:        attack.PeriodicConfig.MaxCounter,
+			PeriodSec:         attack.PeriodicConfig.PeriodSec,
+			PhaseSec:          attack.PeriodicConfig.PhaseSec,
+			SampleIntervalSec: attack.PeriodicConfig.SampleIntervalSec,
+			Points:            attack.PeriodicConfig.Points,
+			Scenarios:         scenarios,
+		}
+	}
+
+	var traceConfig *model.TraceConfig
+	if attack.TraceConfig != nil {
+		points := make([]model.TracePoint, 0, len(attack.TraceConfig.Points))
+		for _, point := range attack.TraceConfig.Points {
+			points = append(points, model.TracePoint{
+				OffsetSec: point.OffsetSec,
+				Values:    point.Values,
+			})
+		}
+
+		var maxUsers *int64
+		if attack.TraceConfig.Mode == core.LoadRPS {
+			maxUsers = &attack.TraceConfig.MaxUsers
+		}
+
+		traceConfig = &model.TraceConfig{
+			Mode:            string(attack.TraceConfig.Mode),
+			TimeCompression: attack.TraceConfig.TimeCompression,
+			MaxUsers:        maxUsers,
+			Points:          points,
+		}
+	}
+
+	var adaptiveConfig *model.AdaptiveConfig
+	if attack.AdaptiveConfig != nil {
+		scenarios := make([]string, 0, len(attack.AdaptiveConfig.Scenarios))
+		for _, scenario := range attack.AdaptiveConfig.Scenarios {
+			scenarios = append(scenarios, scenario.Name)
+		}
+
+		var maxUsers *int64
+		if attack.AdaptiveConfig.Mode == core.LoadRPS {
+			maxUsers = &attack.AdaptiveConfig.MaxUsers
+		}
+
+		adaptiveConfig = &model.AdaptiveConfig{
+			Mode:            string(attack.AdaptiveConfig.Mode),
+			StartValue:      attack.AdaptiveConfig.StartValue,
+			StepValue:       attack.AdaptiveConfig.StepValue,
+			MaxValue:        attack.AdaptiveConfig.MaxValue,
+			Resolution:      attack.AdaptiveConfig.Resolution,
+			StepIntervalSec: attack.AdaptiveConfig.StepIntervalSec,
+			MaxP95Ms:        attack.AdaptiveConfig.MaxP95Ms,
+			MaxErrorRate:    attack.AdaptiveConfig.MaxErrorRate,
+			MaxUsers:        maxUsers,
+			Scenarios:       scenarios,
+		}
+	}
+
+	var spikes []model.SpikeInfo
+	for _, spike := range attack.Spikes {
+		spikes = append(spikes, model.SpikeInfo{
+			IncrementID: spike.IncrementID,
+			StartedAt:   spike.StartedAt,
+			EndedAt:     spike.EndedAt,
+		})
+	}
+
+	var scheduledRun *model.ScheduledRunInfo
+	if attack.ScheduledRun != nil {
+		scheduledRun = &model.ScheduledRunInfo{
+			ScheduleID: attack.ScheduledRun.ScheduleID,
+			Run:        attack.ScheduledRun.Run,
+		}
+	}
+
+	var template *model.TemplateRefInfo
+	if attack.Template != nil {
+		template = &model.TemplateRefInfo{
+			Name:    attack.Template.Name,
+			Version: attack.Template.Version,
 		}
 	}
 
 	return model.AttackInfo{
-		Name:         attack.Name,
-		ID:           attack.ID,
-		WaitTimeSec:  attack.WaitTimeSec,
-		CreatedAt:    attack.CreatedAt,
-		DurationSec:  attack.DurationSec,
-		ConstConfig:  constConfig,
-		LinearConfig: linearConfig,
-		Increments:   incrementInfos,
+		Name:              attack.Name,
+		ID:                attack.ID,
+		WaitTimeSec:       attack.WaitTimeSec,
+		CreatedAt:         attack.CreatedAt,
+		DurationSec:       attack.DurationSec,
+		ConstConfig:       constConfig,
+		LinearConfig:      linearConfig,
+		ArrivalRateConfig: arrivalRateConfig,
+		StagesConfig:      stagesConfig,
+		ActiveStage:       attack.ActiveStage,
+		SpikeConfig:       spikeConfig,
+		Spikes:            spikes,
+		PeriodicConfig:    periodicConfig,
+		TargetCounter:     attack.TargetCounter,
+		TraceConfig:       traceConfig,
+		ActivePoint:       attack.ActivePoint,
+		AdaptiveConfig:    adaptiveConfig,
+		SustainableLoad:   attack.SustainableLoad,
+		Converged:         attack.Converged,
+		ScheduledRun:      scheduledRun,
+		Template:          template,
+		Iterations:        attack.Iterations,
+		IterationsPerUser: attack.IterationsPerUser,
+		Target:            presentAttackTarget(attack.Target),
+		Params:            presentParams(attack.Params),
+		Feeds:             presentFeeds(attack.Feeds),
+		Paused:            attack.Paused,
+		Increments:        incrementInfos,
+	}
+}
+
+// PresentStartAttack presents an attack configuration in the form it is accepted by the start endpoint.
+func PresentStartAttack(start core.StartAttack) model.StartAttackRequestBody {
+	info := PresentAttack(core.AttackDetails{
+		Name:              start.Name,
+		WaitTimeSec:       start.WaitTimeSec,
+		DurationSec:       start.DurationSec,
+		ConstConfig:       start.ConstConfig,
+		LinearConfig:      start.LinearConfig,
+		ArrivalRateConfig: start.ArrivalRateConfig,
+		StagesConfig:      start.StagesConfig,
+		SpikeConfig:       start.SpikeConfig,
+		PeriodicConfig:    start.PeriodicConfig,
+		TraceConfig:       start.TraceConfig,
+		AdaptiveConfig:    start.AdaptiveConfig,
+		Iterations:        start.Iterations,
+		IterationsPerUser: start.IterationsPerUser,
+	})
+
+	return model.StartAttackRequestBody{
+		Name:              info.Name,
+		WaitTimeSec:       info.WaitTimeSec,
+		DurationSec:       info.DurationSec,
+		ConstConfig:       info.ConstConfig,
+		LinearConfig:      info.LinearConfig,
+		ArrivalRateConfig: info.ArrivalRateConfig,
+		StagesConfig:      info.StagesConfig,
+		SpikeConfig:       info.SpikeConfig,
+		PeriodicConfig:    info.PeriodicConfig,
+		TraceConfig:       info.TraceConfig,
+		AdaptiveConfig:    info.AdaptiveConfig,
+		Iterations:        info.Iterations,
+		IterationsPerUser: info.IterationsPerUser,
+		Target:            start.Target,
+		Params:            presentParams(start.Params),
+		Feeds:             presentFeeds(start.Feeds),
+	}
+}
+
+// presentAttackTarget presents the copy of the target an attack is run against.
+//
+// Parameters:
+//   - target: The target of the attack, nil if the attack uses the default hosts
+//
+// Returns:
+//   - *model.TargetInfo: The presented target, nil if there is none
+func presentAttackTarget(target *core.Target) *model.TargetInfo {
+	if target == nil {
+		return nil
+	}
+
+	return &model.TargetInfo{
+		Name:     target.Name,
+		Services: target.Services,
+		Headers:  target.Headers,
 	}
 }
 
@@ -107,6 +437,29 @@ func PresentNode(node core.NodeDetails) model.NodeInfo {
 	}
 }
 
+func PresentSummary(summary core.AttackSummary) model.AttackSummaryInfo {
+	var checks map[string]model.CheckStatsInfo
+	if len(summary.Checks) != 0 {
+		checks = make(map[string]model.CheckStatsInfo, len(summary.Checks))
+		for name, check := range summary.Checks {
+			checks[name] = model.CheckStatsInfo{
+				Passed: check.Passed,
+				Failed: check.Failed,
+			}
+		}
+	}
+
+	return model.AttackSummaryInfo{
+		EndedAt:       summary.EndedAt,
+		Requests:      summary.Requests,
+		Errors:        summary.Errors,
+		ErrorRate:     summary.ErrorRate,
+		P95Ms:         summary.P95Ms,
+		Checks:        checks,
+		SetupFailures: summary.SetupFailures,
+	}
+}
+
 func PresentNodeList(nodes []core.NodeDetails) []model.NodeInfo {
 	pres := make([]model.NodeInfo, 0, len(nodes))
 	for _, node := range nodes {
@@ -144,17 +497,54 @@ func PresentScenarioList(scenarios []core.ScenarioDetails) []model.ScenarioInfo
 }
 
 func (sa *StartAttackPresenter) ToCore() (core.StartAttack, error) {
-	if sa.ConstConfig == nil && sa.LinearConfig == nil {
+	if sa.ConstConfig == nil && sa.LinearConfig == nil && sa.ArrivalRateConfig == nil && sa.StagesConfig == nil && sa.SpikeConfig == nil &&
+		sa.PeriodicConfig == nil && sa.TraceConfig == nil && sa.AdaptiveConfig == nil {
+		return core.StartAttack{}, core.ErrBadConfig
+	}
+
+	// Stages, periodic curves and traces drive the whole attack load, so they cannot be mixed with other strategies
+	if sa.StagesConfig != nil && (sa.ConstConfig != nil || sa.LinearConfig != nil || sa.ArrivalRateConfig != nil ||
+		sa.SpikeConfig != nil || sa.PeriodicConfig != nil || sa.TraceConfig != nil) {
+		return core.StartAttack{}, core.ErrBadConfig
+	}
+	if sa.PeriodicConfig != nil && (sa.ConstConfig != nil || sa.LinearConfig != nil || sa.ArrivalRateConfig != nil ||
+		sa.SpikeConfig != nil || sa.TraceConfig != nil) {
+		return core.StartAttack{}, core.ErrBadConfig
+	}
+	if sa.TraceConfig != nil && (sa.ConstConfig != nil || sa.LinearConfig != nil || sa.ArrivalRateConfig != nil ||
+		sa.SpikeConfig != nil || sa.AdaptiveConfig != nil) {
+		return core.StartAttack{}, core.ErrBadConfig
+	}
+	if sa.AdaptiveConfig != nil && (sa.ConstConfig != nil || sa.LinearConfig != nil || sa.ArrivalRateConfig != nil ||
+		sa.StagesConfig != nil || sa.SpikeConfig != nil || sa.PeriodicConfig != nil) {
+		return core.StartAttack{}, core.ErrBadConfig
+	}
+
+	// Iteration bounds are counted by the closed model users of a constant attack only
+	if (sa.Iterations != nil || sa.IterationsPerUser != nil) && (sa.ConstConfig == nil || sa.LinearConfig != nil ||
+		sa.ArrivalRateConfig != nil || sa.SpikeConfig != nil) {
 		return core.StartAttack{}, core.ErrBadConfig
 	}
 
 	var constConfig *core.ConstConfig
+	var constPacings map[string]core.Pacing
 	if sa.ConstConfig != nil {
+		var err error
+		constPacings, err = parsePacings(sa.ConstConfig.Pacing, slices.Collect(maps.Keys(sa.ConstConfig.Scenarios)))
+		if err != nil {
+			return core.StartAttack{}, err
+		}
+
 		scenarios := make([]core.Scenario, 0, len(sa.ConstConfig.Scenarios))
 		for scenario, counter := range sa.ConstConfig.Scenarios {
+			var pacing *core.Pacing
+			if scenarioPacing, exists := constPacings[scenario]; exists {
+				pacing = &scenarioPacing
+			}
 			scenarios = append(scenarios, core.Scenario{
 				Name:    scenario,
 				Counter: &counter,
+				Pacing:  pacing,
 			})
 		}
 		constConfig = &core.ConstConfig{
@@ -191,10 +581,53 @@ func (sa *StartAttackPresenter) ToCore() (core.StartAttack, error) {
 			return core.StartAttack{}, core.ErrBadConfig
 		}
 
+		if sa.LinearConfig.RampDownSec != nil {
+			// Ramp-down is only possible for attacks with a known end
+			if sa.DurationSec == nil || *sa.LinearConfig.RampDownSec >= *sa.DurationSec {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+
+			// Ramp-up must be over before the ramp-down begins
+			var rampUpSec int64
+			if sa.LinearConfig.WarmUpSec != nil {
+				rampUpSec = *sa.LinearConfig.WarmUpSec
+			} else {
+				interval := int64(1)
+				if sa.LinearConfig.StepIntervalSec != nil {
+					interval = *sa.LinearConfig.StepIntervalSec
+				}
+				step := *sa.LinearConfig.CounterStep
+				rampUpSec = (sa.LinearConfig.EndCounter - sa.LinearConfig.StartCounter + step - 1) / step * interval
+			}
+			if rampUpSec+*sa.LinearConfig.RampDownSec > *sa.DurationSec {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+		}
+
+		pacings, err := parsePacings(sa.LinearConfig.Pacing, sa.LinearConfig.Scenarios)
+		if err != nil {
+			return core.StartAttack{}, err
+		}
+
 		scenarios := make([]core.Scenario, 0, len(sa.LinearConfig.Scenarios))
 		for _, scenario := range sa.LinearConfig.Scenarios {
+			var pacing *core.Pacing
+			if scenarioPacing, exists := pacings[scenario]; exists {
+				pacing = &scenarioPacing
+			}
+
+			// Users of a scenario shared by both configurations run in the same increment with a single pacing
+			if sa.ConstConfig != nil {
+				_, shared := sa.ConstConfig.Scenarios[scenario]
+				constPacing, constPaced := constPacings[scenario]
+				if shared && (constPaced != (pacing != nil) || (pacing != nil && constPacing != *pacing)) {
+					return core.StartAttack{}, fmt.Errorf("%w: conflicting pacing of scenario %s", core.ErrBadConfig, scenario)
+				}
+			}
+
 			scenarios = append(scenarios, core.Scenario{
-				Name: scenario,
+				Name:   scenario,
+				Pacing: pacing,
 			})
 		}
 
@@ -204,24 +637,302 @@ func (sa *StartAttackPresenter) ToCore() (core.StartAttack, error) {
 			EndCounter:      sa.LinearConfig.EndCounter,
 			CounterStep:     sa.LinearConfig.CounterStep,
 			StepIntervalSec: sa.LinearConfig.StepIntervalSec,
+			RampDownSec:     sa.LinearConfig.RampDownSec,
 			Scenarios:       scenarios,
 		}
 	}
 
+	var arrivalRateConfig *core.ArrivalRateConfig
+	if sa.ArrivalRateConfig != nil {
+		if sa.ArrivalRateConfig.RampUpSec != nil && sa.DurationSec != nil && *sa.ArrivalRateConfig.RampUpSec >= *sa.DurationSec {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		scenarios := make([]core.RateScenario, 0, len(sa.ArrivalRateConfig.Scenarios))
+		for scenario, rate := range sa.ArrivalRateConfig.Scenarios {
+			// Ramping needs both the target rate and the ramp time
+			if rate.TargetRate != nil && sa.ArrivalRateConfig.RampUpSec == nil {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+
+			// Open model scenarios cannot share their users with the closed model ones
+			if sa.ConstConfig != nil {
+				if _, exists := sa.ConstConfig.Scenarios[scenario]; exists {
+					return core.StartAttack{}, core.ErrBadConfig
+				}
+			}
+			if sa.LinearConfig != nil && slices.Contains(sa.LinearConfig.Scenarios, scenario) {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+
+			scenarios = append(scenarios, core.RateScenario{
+				Name:       scenario,
+				Rate:       rate.Rate,
+				TargetRate: rate.TargetRate,
+				MaxUsers:   rate.MaxUsers,
+			})
+		}
+
+		arrivalRateConfig = &core.ArrivalRateConfig{
+			RampUpSec: sa.ArrivalRateConfig.RampUpSec,
+			Scenarios: scenarios,
+		}
+	}
+
+	var stagesConfig *core.StagesConfig
+	if sa.StagesConfig != nil {
+		stages := make([]core.Stage, 0, len(sa.StagesConfig.Stages))
+		for i, stage := range sa.StagesConfig.Stages {
+			// Only the last stage may bring the load down to zero
+			if stage.TargetCounter == 0 && i != len(sa.StagesConfig.Stages)-1 {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+
+			ramp := core.RampLinear
+			if stage.Ramp != "" {
+				ramp = core.RampType(stage.Ramp)
+			}
+
+			stages = append(stages, core.Stage{
+				TargetCounter: stage.TargetCounter,
+				DurationSec:   stage.DurationSec,
+				Ramp:          ramp,
+			})
+		}
+
+		scenarios := make([]core.Scenario, 0, len(sa.StagesConfig.Scenarios))
+		for _, scenario := range sa.StagesConfig.Scenarios {
+			scenarios = append(scenarios, core.Scenario{
+				Name: scenario,
+			})
+		}
+
+		stagesConfig = &core.StagesConfig{
+			StartCounter: sa.StagesConfig.StartCounter,
+			Stages:       stages,
+			Scenarios:    scenarios,
+		}
+	}
+
+	var spikeConfig *core.SpikeConfig
+	if sa.SpikeConfig != nil {
+		// All spikes must fit into the attack duration
+		spikesSec := sa.SpikeConfig.SpikeCount * (sa.SpikeConfig.IntervalSec + sa.SpikeConfig.SpikeDurationSec)
+		if sa.DurationSec != nil && spikesSec > *sa.DurationSec {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		scenarios := make([]core.Scenario, 0, len(sa.SpikeConfig.Scenarios))
+		for _, scenario := range sa.SpikeConfig.Scenarios {
+			// Spike users cannot be added to the open model scenarios
+			if sa.ArrivalRateConfig != nil {
+				if _, exists := sa.ArrivalRateConfig.Scenarios[scenario]; exists {
+					return core.StartAttack{}, core.ErrBadConfig
+				}
+			}
+
+			scenarios = append(scenarios, core.Scenario{
+				Name: scenario,
+			})
+		}
+
+		spikeConfig = &core.SpikeConfig{
+			BaselineCounter:  sa.SpikeConfig.BaselineCounter,
+			SpikeCounter:     sa.SpikeConfig.SpikeCounter,
+			SpikeDurationSec: sa.SpikeConfig.SpikeDurationSec,
+			SpikeCount:       sa.SpikeConfig.SpikeCount,
+			IntervalSec:      sa.SpikeConfig.IntervalSec,
+			Scenarios:        scenarios,
+		}
+	}
+
+	var periodicConfig *core.PeriodicConfig
+	if sa.PeriodicConfig != nil {
+		if sa.PeriodicConfig.MaxCounter < sa.PeriodicConfig.MinCounter {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		if sa.PeriodicConfig.SampleIntervalSec > sa.PeriodicConfig.PeriodSec {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		scenarios := make([]core.Scenario, 0, len(sa.PeriodicConfig.Scenarios))
+		for _, scenario := range sa.PeriodicConfig.Scenarios {
+			scenarios = append(scenarios, core.Scenario{
+				Name: scenario,
+			})
+		}
+
+		periodicConfig = &core.PeriodicConfig{
+			MinCounter:        sa.PeriodicConfig.MinCounter,
+			MaxCounter:        sa.PeriodicConfig.MaxCounter,
+			PeriodSec:         sa.PeriodicConfig.PeriodSec,
+			PhaseSec:          sa.PeriodicConfig.PhaseSec,
+			SampleIntervalSec: sa.PeriodicConfig.SampleIntervalSec,
+			Points:            sa.PeriodicConfig.Points,
+			Scenarios:         scenarios,
+		}
+	}
+
+	var traceConfig *core.TraceConfig
+	if sa.TraceConfig != nil {
+		// The series is given either as CSV or as a list of points
+		if (sa.TraceConfig.Series == "") == (len(sa.TraceConfig.Points) == 0) {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		points := toTracePoints(sa.TraceConfig.Points)
+		if sa.TraceConfig.Series != "" {
+			var err error
+			if points, err = parseSeries(sa.TraceConfig.Series); err != nil {
+				return core.StartAttack{}, err
+			}
+		}
+
+		// Points must follow each other and share the same scenarios
+		for i, point := range points {
+			if i != 0 && point.OffsetSec <= points[i-1].OffsetSec {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+			if len(point.Values) != len(points[0].Values) {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+			for scenario := range point.Values {
+				if _, exists := points[0].Values[scenario]; !exists {
+					return core.StartAttack{}, core.ErrBadConfig
+				}
+			}
+		}
+
+		// The RPS mode needs the users pool bound
+		mode := core.LoadMode(sa.TraceConfig.Mode)
+		var maxUsers int64
+		if mode == core.LoadRPS {
+			if sa.TraceConfig.MaxUsers == nil {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+			maxUsers = *sa.TraceConfig.MaxUsers
+		}
+
+		timeCompression := 1.0
+		if sa.TraceConfig.TimeCompression != 0 {
+			timeCompression = sa.TraceConfig.TimeCompression
+		}
+
+		scenarios := make([]core.Scenario, 0, len(points[0].Values))
+		for scenario := range points[0].Values {
+			scenarios = append(scenarios, core.Scenario{
+				Name: scenario,
+			})
+		}
+
+		traceConfig = &core.TraceConfig{
+			Mode:            mode,
+			TimeCompression: timeCompression,
+			MaxUsers:        maxUsers,
+			Points:          points,
+			Scenarios:       scenarios,
+		}
+	}
+
+	var adaptiveConfig *core.AdaptiveConfig
+	if sa.AdaptiveConfig != nil {
+		if sa.AdaptiveConfig.MaxValue != nil && *sa.AdaptiveConfig.MaxValue < sa.AdaptiveConfig.StartValue {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		if sa.DurationSec != nil && sa.AdaptiveConfig.StepIntervalSec >= *sa.DurationSec {
+			return core.StartAttack{}, core.ErrBadConfig
+		}
+
+		// The RPS mode needs the users pool bound
+		mode := core.LoadMode(sa.AdaptiveConfig.Mode)
+		var maxUsers int64
+		if mode == core.LoadRPS {
+			if sa.AdaptiveConfig.MaxUsers == nil {
+				return core.StartAttack{}, core.ErrBadConfig
+			}
+			maxUsers = *sa.AdaptiveConfig.MaxUsers
+		}
+
+		scenarios := make([]core.Scenario, 0, len(sa.AdaptiveConfig.Scenarios))
+		for _, scenario := range sa.AdaptiveConfig.Scenarios {
+			scenarios = append(scenarios, core.Scenario{
+				Name: scenario,
+			})
+		}
+
+		adaptiveConfig = &core.AdaptiveConfig{
+			Mode:            mode,
+			StartValue:      sa.AdaptiveConfig.StartValue,
+			StepValue:       sa.AdaptiveConfig.StepValue,
+			MaxValue:        sa.AdaptiveConfig.MaxValue,
+			Resolution:      sa.AdaptiveConfig.Resolution,
+			StepIntervalSec: sa.AdaptiveConfig.StepIntervalSec,
+			MaxP95Ms:        sa.AdaptiveConfig.MaxP95Ms,
+			MaxErrorRate:    sa.AdaptiveConfig.MaxErrorRate,
+			MaxUsers:        maxUsers,
+			Scenarios:       scenarios,
+		}
+	}
+
+	feeds, err := parseFeeds(sa.Feeds)
+	if err != nil {
+		return core.StartAttack{}, err
+	}
+
 	return core.StartAttack{
-		Name:         sa.Name,
-		WaitTimeSec:  sa.WaitTimeSec,
-		DurationSec:  sa.DurationSec,
-		ConstConfig:  constConfig,
-		LinearConfig: linearConfig,
+		Name:              sa.Name,
+		WaitTimeSec:       sa.WaitTimeSec,
+		DurationSec:       sa.DurationSec,
+		ConstConfig:       constConfig,
+		LinearConfig:      linearConfig,
+		ArrivalRateConfig: arrivalRateConfig,
+		StagesConfig:      stagesConfig,
+		SpikeConfig:       spikeConfig,
+		PeriodicConfig:    periodicConfig,
+		TraceConfig:       traceConfig,
+		AdaptiveConfig:    adaptiveConfig,
+		Iterations:        sa.Iterations,
+		IterationsPerUser: sa.IterationsPerUser,
+		Target:            sa.Target,
+		Params:            parseParams(sa.Params),
+		Feeds:             feeds,
 	}, nil
 }
 
-func (si *StartIncrementPresenter) ToCore(attackID int64) core.OperationStart {
+func (si *StartIncrementPresenter) ToCore(attackID int64) (core.OperationStart, *int64) {
 	return core.OperationStart{
 		AttackID:  attackID,
 		Scenarios: si.Scenarios,
+	}, si.TTLSec
+}
+
+func (sp *ScalePresenter) ToCore() map[string]int64 {
+	return sp.Scenarios
+}
+
+func (sp *StopPresenter) ToCore() (core.StopOptions, error) {
+	if core.StopMode(sp.Mode) != core.StopGraceful {
+		// The drain timeout only makes sense for a graceful stop
+		if sp.DrainTimeoutSec != nil {
+			return core.StopOptions{}, core.ErrBadConfig
+		}
+
+		return core.StopOptions{
+			Mode: core.StopImmediate,
+		}, nil
 	}
+
+	drainTimeoutSec := int64(defaultDrainTimeoutSec)
+	if sp.DrainTimeoutSec != nil {
+		drainTimeoutSec = *sp.DrainTimeoutSec
+	}
+
+	return core.StopOptions{
+		Mode:            core.StopGraceful,
+		DrainTimeoutSec: drainTimeoutSec,
+	}, nil
 }
 
 func PresentStringList(list []string) []string {
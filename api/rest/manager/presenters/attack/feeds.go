@@ -0,0 +1,62 @@
+package attack
+
+import (
+	"fmt"
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"slices"
+)
+
+// parseFeeds converts the datasets fed to an attack.
+//
+// Parameters:
+//   - feeds: The feeds of the attack
+//
+// Returns:
+//   - []core.Feed: The converted feeds, nil if there are none
+//   - error: core.ErrBadConfig if a dataset is fed twice
+func parseFeeds(feeds []model.FeedConfig) ([]core.Feed, error) {
+	if len(feeds) == 0 {
+		return nil, nil
+	}
+
+	parsed := make([]core.Feed, 0, len(feeds))
+	for _, feed := range feeds {
+		fed := slices.ContainsFunc(parsed, func(parsedFeed core.Feed) bool {
+			return parsedFeed.Dataset == feed.Dataset
+		})
+		if fed {
+			return nil, fmt.Errorf("%w: dataset %s is fed twice", core.ErrBadConfig, feed.Dataset)
+		}
+
+		parsed = append(parsed, core.Feed{
+			Dataset: feed.Dataset,
+			Mode:    core.FeedMode(feed.Mode),
+		})
+	}
+
+	return parsed, nil
+}
+
+// presentFeeds converts the datasets fed to an attack back to their request form.
+//
+// Parameters:
+//   - feeds: The feeds of the attack
+//
+// Returns:
+//   - []model.FeedConfig: The presented feeds, nil if there are none
+func presentFeeds(feeds []core.Feed) []model.FeedConfig {
+	if len(feeds) == 0 {
+		return nil
+	}
+
+	pres := make([]model.FeedConfig, 0, len(feeds))
+	for _, feed := range feeds {
+		pres = append(pres, model.FeedConfig{
+			Dataset: feed.Dataset,
+			Mode:    string(feed.Mode),
+		})
+	}
+
+	return pres
+}
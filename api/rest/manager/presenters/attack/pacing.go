@@ -0,0 +1,89 @@
+package attack
+
+import (
+	"fmt"
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"slices"
+)
+
+// parsePacings converts the pacing of the scenarios of a configuration.
+//
+// Parameters:
+//   - pacings: Pacing per scenario name
+//   - scenarios: Names of the scenarios of the configuration
+//
+// Returns:
+//   - map[string]core.Pacing: Pacing per scenario name
+//   - error: core.ErrBadConfig if a pacing is invalid or targets an unknown scenario
+func parsePacings(pacings map[string]model.PacingConfig, scenarios []string) (map[string]core.Pacing, error) {
+	parsed := make(map[string]core.Pacing, len(pacings))
+	for scenario, pacing := range pacings {
+		if !slices.Contains(scenarios, scenario) {
+			return nil, fmt.Errorf("%w: pacing of unknown scenario %s", core.ErrBadConfig, scenario)
+		}
+
+		corePacing, err := parsePacing(pacing)
+		if err != nil {
+			return nil, err
+		}
+		parsed[scenario] = corePacing
+	}
+
+	return parsed, nil
+}
+
+// parsePacing converts the pacing of a scenario.
+// Uniform think time needs its bounds, every other model needs its interval.
+//
+// Parameters:
+//   - pacing: The pacing to convert
+//
+// Returns:
+//   - core.Pacing: The converted pacing
+//   - error: core.ErrBadConfig if the pacing parameters do not match its model
+func parsePacing(pacing model.PacingConfig) (core.Pacing, error) {
+	mode := core.PacingMode(pacing.Mode)
+	if mode == core.PacingUniformThink {
+		if pacing.IntervalSec != nil || pacing.MinSec == nil || pacing.MaxSec == nil || *pacing.MaxSec < *pacing.MinSec {
+			return core.Pacing{}, fmt.Errorf("%w: uniform think time needs min_sec and max_sec only", core.ErrBadConfig)
+		}
+
+		return core.Pacing{
+			Mode:   mode,
+			MinSec: *pacing.MinSec,
+			MaxSec: *pacing.MaxSec,
+		}, nil
+	}
+
+	if pacing.IntervalSec == nil || pacing.MinSec != nil || pacing.MaxSec != nil {
+		return core.Pacing{}, fmt.Errorf("%w: %s pacing needs interval_sec only", core.ErrBadConfig, mode)
+	}
+
+	return core.Pacing{
+		Mode:        mode,
+		IntervalSec: *pacing.IntervalSec,
+	}, nil
+}
+
+// presentPacing converts the pacing of a scenario back to its request form.
+//
+// Parameters:
+//   - pacing: The pacing to present
+//
+// Returns:
+//   - model.PacingConfig: The presented pacing
+func presentPacing(pacing core.Pacing) model.PacingConfig {
+	if pacing.Mode == core.PacingUniformThink {
+		return model.PacingConfig{
+			Mode:   string(pacing.Mode),
+			MinSec: &pacing.MinSec,
+			MaxSec: &pacing.MaxSec,
+		}
+	}
+
+	return model.PacingConfig{
+		Mode:        string(pacing.Mode),
+		IntervalSec: &pacing.IntervalSec,
+	}
+}
@@ -0,0 +1,73 @@
+package attack
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+)
+
+// parseParams converts the parameters of the scenarios of an attack.
+// They are checked by the attack service against the schemas advertised by the nodes.
+//
+// Parameters:
+//   - params: Parameters per scenario name
+//
+// Returns:
+//   - map[string]core.Params: Parameters per scenario name, nil if there are none
+func parseParams(params map[string]map[string]any) map[string]core.Params {
+	if len(params) == 0 {
+		return nil
+	}
+
+	parsed := make(map[string]core.Params, len(params))
+	for scenario, scenarioParams := range params {
+		parsed[scenario] = scenarioParams
+	}
+
+	return parsed
+}
+
+// presentParams converts the parameters of the scenarios of an attack back to their request form.
+//
+// Parameters:
+//   - params: Parameters per scenario name
+//
+// Returns:
+//   - map[string]map[string]any: The presented parameters, nil if there are none
+func presentParams(params map[string]core.Params) map[string]map[string]any {
+	if len(params) == 0 {
+		return nil
+	}
+
+	pres := make(map[string]map[string]any, len(params))
+	for scenario, scenarioParams := range params {
+		pres[scenario] = scenarioParams
+	}
+
+	return pres
+}
+
+// presentParameters presents the parameter schema of a scenario.
+//
+// Parameters:
+//   - parameters: Schema of the parameters of the scenario
+//
+// Returns:
+//   - []model.ScenarioParameterInfo: The presented schema, nil if the scenario has no parameters
+func presentParameters(parameters []core.ScenarioParameter) []model.ScenarioParameterInfo {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	pres := make([]model.ScenarioParameterInfo, 0, len(parameters))
+	for _, parameter := range parameters {
+		pres = append(pres, model.ScenarioParameterInfo{
+			Name:        parameter.Name,
+			Type:        string(parameter.Type),
+			Description: parameter.Description,
+			Required:    parameter.Required,
+			Default:     parameter.Default,
+		})
+	}
+
+	return pres
+}
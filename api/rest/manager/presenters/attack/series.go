@@ -0,0 +1,103 @@
+package attack
+
+import (
+	"encoding/csv"
+	"fmt"
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseSeries reads a time series from its CSV representation.
+// The header holds the timestamp column followed by the scenario names, every other row holds
+// a timestamp and the values of each scenario. Timestamps are either seconds or RFC 3339 times,
+// and the offsets of the points are counted from the first row.
+//
+// Parameters:
+//   - series: CSV content of the series
+//
+// Returns:
+//   - []core.TracePoint: Points of the series
+//   - error: core.ErrBadConfig if the series is malformed
+func parseSeries(series string) ([]core.TracePoint, error) {
+	records, err := csv.NewReader(strings.NewReader(series)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", core.ErrBadConfig, err)
+	}
+	if len(records) < 2 || len(records[0]) < 2 {
+		return nil, fmt.Errorf("%w: series must have a header and at least one row", core.ErrBadConfig)
+	}
+
+	scenarios := records[0][1:]
+	points := make([]core.TracePoint, 0, len(records)-1)
+
+	var start float64
+	for i, record := range records[1:] {
+		timestamp, err := parseTimestamp(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: %v", core.ErrBadConfig, i+1, err)
+		}
+		if i == 0 {
+			start = timestamp
+		}
+
+		values := make(map[string]float64, len(scenarios))
+		for j, scenario := range scenarios {
+			value, err := strconv.ParseFloat(strings.TrimSpace(record[j+1]), 64)
+			if err != nil || value < 0 {
+				return nil, fmt.Errorf("%w: row %d: bad value of %s", core.ErrBadConfig, i+1, scenario)
+			}
+			values[strings.TrimSpace(scenario)] = value
+		}
+
+		points = append(points, core.TracePoint{
+			OffsetSec: timestamp - start,
+			Values:    values,
+		})
+	}
+
+	return points, nil
+}
+
+// parseTimestamp reads a series timestamp given either in seconds or as an RFC 3339 time.
+//
+// Parameters:
+//   - timestamp: The timestamp to parse
+//
+// Returns:
+//   - float64: The timestamp in seconds
+//   - error: Parsing error if the timestamp has neither format
+func parseTimestamp(timestamp string) (float64, error) {
+	timestamp = strings.TrimSpace(timestamp)
+	if seconds, err := strconv.ParseFloat(timestamp, 64); err == nil {
+		return seconds, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return 0, fmt.Errorf("bad timestamp %q", timestamp)
+	}
+
+	return float64(t.UnixNano()) / float64(time.Second), nil
+}
+
+// toTracePoints converts the points given in the request into the series points.
+//
+// Parameters:
+//   - points: Points of the request
+//
+// Returns:
+//   - []core.TracePoint: Points of the series
+func toTracePoints(points []model.TracePoint) []core.TracePoint {
+	tracePoints := make([]core.TracePoint, 0, len(points))
+	for _, point := range points {
+		tracePoints = append(tracePoints, core.TracePoint{
+			OffsetSec: point.OffsetSec,
+			Values:    point.Values,
+		})
+	}
+
+	return tracePoints
+}
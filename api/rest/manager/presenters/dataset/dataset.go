@@ -0,0 +1,142 @@
+package dataset
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"slices"
+	"sort"
+)
+
+// ParseDataset reads an uploaded dataset.
+// A CSV dataset names its columns in its header row, a JSONL dataset holds one JSON object per line,
+// whose string values are kept as they are and other values in their JSON form.
+//
+// Parameters:
+//   - name: Name of the dataset
+//   - format: Format of the dataset, csv or jsonl
+//   - body: Content of the dataset
+//
+// Returns:
+//   - core.Dataset: The parsed dataset
+//   - error: core.ErrBadDataset if the format is unknown or the content is malformed
+func ParseDataset(name, format string, body []byte) (core.Dataset, error) {
+	var dataset core.Dataset
+	var err error
+	switch core.DatasetFormat(format) {
+	case core.DatasetCSV:
+		dataset, err = parseCSV(body)
+	case core.DatasetJSONL:
+		dataset, err = parseJSONL(body)
+	default:
+		return core.Dataset{}, fmt.Errorf("%w: unknown format %q", core.ErrBadDataset, format)
+	}
+	if err != nil {
+		return core.Dataset{}, fmt.Errorf("%w: %v", core.ErrBadDataset, err)
+	}
+
+	dataset.Name = name
+	dataset.Format = core.DatasetFormat(format)
+
+	return dataset, nil
+}
+
+func parseCSV(body []byte) (core.Dataset, error) {
+	reader := csv.NewReader(bytes.NewReader(body))
+	columns, err := reader.Read()
+	if err != nil {
+		return core.Dataset{}, fmt.Errorf("header row: %v", err)
+	}
+	for i, column := range columns {
+		if column == "" || slices.Contains(columns[:i], column) {
+			return core.Dataset{}, fmt.Errorf("bad column name %q", column)
+		}
+	}
+
+	var rows []core.DataRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return core.Dataset{}, err
+		}
+
+		row := make(core.DataRow, len(columns))
+		for i, column := range columns {
+			row[column] = record[i]
+		}
+		rows = append(rows, row)
+	}
+
+	return core.Dataset{
+		Columns: columns,
+		Rows:    rows,
+	}, nil
+}
+
+func parseJSONL(body []byte) (core.Dataset, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+
+	var columns []string
+	var rows []core.DataRow
+	for decoder.More() {
+		var object map[string]json.RawMessage
+		if err := decoder.Decode(&object); err != nil {
+			return core.Dataset{}, fmt.Errorf("row %d: %v", len(rows), err)
+		}
+
+		row := make(core.DataRow, len(object))
+		for column, raw := range object {
+			var text string
+			if err := json.Unmarshal(raw, &text); err != nil {
+				// Not a string, the value is kept in its JSON form
+				var compacted bytes.Buffer
+				if err := json.Compact(&compacted, raw); err != nil {
+					return core.Dataset{}, fmt.Errorf("row %d: %v", len(rows), err)
+				}
+				text = compacted.String()
+			}
+			row[column] = text
+
+			if !slices.Contains(columns, column) {
+				columns = append(columns, column)
+			}
+		}
+		rows = append(rows, row)
+	}
+	sort.Strings(columns)
+
+	return core.Dataset{
+		Columns: columns,
+		Rows:    rows,
+	}, nil
+}
+
+func PresentDataset(dataset core.DatasetDetails) model.DatasetInfo {
+	return model.DatasetInfo{
+		Name:       dataset.Name,
+		Format:     string(dataset.Format),
+		Columns:    dataset.Columns,
+		RowCount:   dataset.RowCount,
+		UploadedAt: dataset.UploadedAt,
+	}
+}
+
+func PresentDatasetList(datasets []core.DatasetDetails) []model.DatasetInfo {
+	pres := make([]model.DatasetInfo, 0, len(datasets))
+	for _, dataset := range datasets {
+		pres = append(pres, PresentDataset(dataset))
+	}
+	sort.Slice(pres, func(i, j int) bool {
+		return pres[i].Name < pres[j].Name
+	})
+
+	return pres
+}
@@ -0,0 +1,57 @@
+package lease
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"sort"
+)
+
+type SaveLeasePoolPresenter model.SaveLeasePoolRequestBody
+
+func (sp *SaveLeasePoolPresenter) ToCore(name string) core.LeasePool {
+	return core.LeasePool{
+		Name:      name,
+		Resources: sp.Resources,
+	}
+}
+
+func PresentLeasePool(pool core.LeasePoolDetails) model.LeasePoolInfo {
+	leases := make([]model.LeaseInfo, 0, len(pool.Leases))
+	for _, lease := range pool.Leases {
+		leases = append(leases, model.LeaseInfo{
+			Resource:   lease.Resource,
+			Node:       lease.Node,
+			AcquiredAt: lease.AcquiredAt,
+		})
+	}
+	sort.Slice(leases, func(i, j int) bool {
+		return leases[i].AcquiredAt.Before(leases[j].AcquiredAt)
+	})
+
+	var utilization float64
+	if len(pool.Resources) != 0 {
+		utilization = float64(len(pool.Leases)) / float64(len(pool.Resources))
+	}
+
+	return model.LeasePoolInfo{
+		Name:        pool.Name,
+		Resources:   pool.Resources,
+		Leased:      len(pool.Leases),
+		Waiting:     pool.Waiting,
+		Utilization: utilization,
+		Leases:      leases,
+		UpdatedAt:   pool.UpdatedAt,
+	}
+}
+
+func PresentLeasePoolList(pools []core.LeasePoolDetails) []model.LeasePoolInfo {
+	pres := make([]model.LeasePoolInfo, 0, len(pools))
+	for _, pool := range pools {
+		pres = append(pres, PresentLeasePool(pool))
+	}
+	sort.Slice(pres, func(i, j int) bool {
+		return pres[i].Name < pres[j].Name
+	})
+
+	return pres
+}
@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/attack"
+	"load-generation-system/internal/core"
+	"sort"
+)
+
+type StartSchedulePresenter model.StartScheduleRequestBody
+
+func PresentSchedule(schedule core.ScheduleDetails) model.ScheduleInfo {
+	runs := make([]model.ScheduleRunInfo, 0, len(schedule.Runs))
+	for _, run := range schedule.Runs {
+		runs = append(runs, model.ScheduleRunInfo{
+			Run:       run.Run,
+			StartedAt: run.StartedAt,
+			AttackID:  run.AttackID,
+			Error:     run.Error,
+		})
+	}
+
+	return model.ScheduleInfo{
+		ID:        schedule.ID,
+		Name:      schedule.Name,
+		StartAt:   schedule.StartAt,
+		Cron:      schedule.Cron,
+		Attack:    attack.PresentStartAttack(schedule.Attack),
+		CreatedAt: schedule.CreatedAt,
+		NextRunAt: schedule.NextRunAt,
+		Runs:      runs,
+	}
+}
+
+func PresentScheduleList(schedules []core.ScheduleDetails) []model.ScheduleInfo {
+	pres := make([]model.ScheduleInfo, 0, len(schedules))
+	for _, schedule := range schedules {
+		pres = append(pres, PresentSchedule(schedule))
+	}
+	sort.Slice(pres, func(i, j int) bool {
+		return pres[i].ID < pres[j].ID
+	})
+
+	return pres
+}
+
+func (ss *StartSchedulePresenter) ToCore() (core.StartSchedule, error) {
+	if (ss.StartAt == nil) == (ss.Cron == nil) {
+		return core.StartSchedule{}, core.ErrBadConfig
+	}
+
+	attackPresenter := attack.StartAttackPresenter(ss.Attack)
+	start, err := attackPresenter.ToCore()
+	if err != nil {
+		return core.StartSchedule{}, err
+	}
+
+	return core.StartSchedule{
+		Name:    ss.Name,
+		StartAt: ss.StartAt,
+		Cron:    ss.Cron,
+		Attack:  start,
+	}, nil
+}
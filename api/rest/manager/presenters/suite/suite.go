@@ -0,0 +1,103 @@
+package suite
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/api/rest/manager/presenters/attack"
+	"load-generation-system/internal/core"
+	"sort"
+)
+
+type StartSuitePresenter model.StartSuiteRequestBody
+
+func PresentSuite(suite core.SuiteDetails) model.SuiteInfo {
+	steps := make([]model.SuiteStep, 0, len(suite.Steps))
+	for _, step := range suite.Steps {
+		var gate *model.SuiteGate
+		if step.Gate != nil {
+			gate = &model.SuiteGate{
+				MaxErrorRate: step.Gate.MaxErrorRate,
+				MaxP95Ms:     step.Gate.MaxP95Ms,
+				MinRequests:  step.Gate.MinRequests,
+			}
+		}
+
+		steps = append(steps, model.SuiteStep{
+			Name:     step.Name,
+			DelaySec: step.DelaySec,
+			Gate:     gate,
+			Attack:   attack.PresentStartAttack(step.Attack),
+		})
+	}
+
+	results := make([]model.SuiteStepResultInfo, 0, len(suite.Results))
+	for _, result := range suite.Results {
+		var summary *model.AttackSummaryInfo
+		if result.Summary != nil {
+			info := attack.PresentSummary(*result.Summary)
+			summary = &info
+		}
+
+		results = append(results, model.SuiteStepResultInfo{
+			Step:      result.Step,
+			AttackID:  result.AttackID,
+			StartedAt: result.StartedAt,
+			Summary:   summary,
+			Error:     result.Error,
+		})
+	}
+
+	return model.SuiteInfo{
+		ID:         suite.ID,
+		Name:       suite.Name,
+		Steps:      steps,
+		Status:     string(suite.Status),
+		ActiveStep: suite.ActiveStep,
+		Results:    results,
+		CreatedAt:  suite.CreatedAt,
+		EndedAt:    suite.EndedAt,
+	}
+}
+
+func PresentSuiteList(suites []core.SuiteDetails) []model.SuiteInfo {
+	pres := make([]model.SuiteInfo, 0, len(suites))
+	for _, suite := range suites {
+		pres = append(pres, PresentSuite(suite))
+	}
+	sort.Slice(pres, func(i, j int) bool {
+		return pres[i].ID < pres[j].ID
+	})
+
+	return pres
+}
+
+func (ss *StartSuitePresenter) ToCore() (core.StartSuite, error) {
+	steps := make([]core.SuiteStep, 0, len(ss.Steps))
+	for _, step := range ss.Steps {
+		attackPresenter := attack.StartAttackPresenter(step.Attack)
+		start, err := attackPresenter.ToCore()
+		if err != nil {
+			return core.StartSuite{}, err
+		}
+
+		var gate *core.SuiteGate
+		if step.Gate != nil {
+			gate = &core.SuiteGate{
+				MaxErrorRate: step.Gate.MaxErrorRate,
+				MaxP95Ms:     step.Gate.MaxP95Ms,
+				MinRequests:  step.Gate.MinRequests,
+			}
+		}
+
+		steps = append(steps, core.SuiteStep{
+			Name:     step.Name,
+			DelaySec: step.DelaySec,
+			Gate:     gate,
+			Attack:   start,
+		})
+	}
+
+	return core.StartSuite{
+		Name:  ss.Name,
+		Steps: steps,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package target
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"sort"
+)
+
+type SaveTargetPresenter model.SaveTargetRequestBody
+
+func (st *SaveTargetPresenter) ToCore(name string) core.Target {
+	return core.Target{
+		Name:     name,
+		Services: st.Services,
+		Headers:  st.Headers,
+	}
+}
+
+func PresentTarget(target core.TargetDetails) model.TargetInfo {
+	return model.TargetInfo{
+		Name:      target.Name,
+		Services:  target.Services,
+		Headers:   target.Headers,
+		UpdatedAt: &target.UpdatedAt,
+	}
+}
+
+func PresentTargetList(targets []core.TargetDetails) []model.TargetInfo {
+	pres := make([]model.TargetInfo, 0, len(targets))
+	for _, target := range targets {
+		pres = append(pres, PresentTarget(target))
+	}
+	sort.Slice(pres, func(i, j int) bool {
+		return pres[i].Name < pres[j].Name
+	})
+
+	return pres
+}
@@ -0,0 +1,109 @@
+package template
+
+import (
+	"load-generation-system/api/rest/manager/handlers/model"
+	"load-generation-system/internal/core"
+	"sort"
+)
+
+type StartTemplatePresenter model.StartTemplateRequestBody
+
+type UpdateTemplatePresenter model.UpdateTemplateRequestBody
+
+type RunTemplatePresenter model.RunTemplateRequestBody
+
+func PresentTemplate(template core.TemplateDetails) model.TemplateInfo {
+	return model.TemplateInfo{
+		Name:        template.Name,
+		Version:     template.Version,
+		Description: template.Description,
+		Parameters:  presentParameters(template.Parameters),
+		Attack:      template.Attack,
+		CreatedAt:   template.CreatedAt,
+	}
+}
+
+func PresentTemplateList(templates []core.TemplateDetails) []model.TemplateInfo {
+	pres := make([]model.TemplateInfo, 0, len(templates))
+	for _, template := range templates {
+		pres = append(pres, PresentTemplate(template))
+	}
+	sort.Slice(pres, func(i, j int) bool {
+		if pres[i].Name != pres[j].Name {
+			return pres[i].Name < pres[j].Name
+		}
+		return pres[i].Version < pres[j].Version
+	})
+
+	return pres
+}
+
+// PresentTemplateFile presents a template version in the form it is exported to and imported from files.
+func PresentTemplateFile(template core.TemplateDetails) model.StartTemplateRequestBody {
+	return model.StartTemplateRequestBody{
+		Name:        template.Name,
+		Description: template.Description,
+		Parameters:  presentParameters(template.Parameters),
+		Attack:      template.Attack,
+	}
+}
+
+func presentParameters(parameters []core.TemplateParameter) []model.TemplateParameter {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	pres := make([]model.TemplateParameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		pres = append(pres, model.TemplateParameter{
+			Name:        parameter.Name,
+			Description: parameter.Description,
+			Default:     parameter.Default,
+		})
+	}
+
+	return pres
+}
+
+func parametersToCore(parameters []model.TemplateParameter) []core.TemplateParameter {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	result := make([]core.TemplateParameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		result = append(result, core.TemplateParameter{
+			Name:        parameter.Name,
+			Description: parameter.Description,
+			Default:     parameter.Default,
+		})
+	}
+
+	return result
+}
+
+func (st *StartTemplatePresenter) ToCore() core.StartTemplate {
+	return core.StartTemplate{
+		Name:        st.Name,
+		Description: st.Description,
+		Parameters:  parametersToCore(st.Parameters),
+		Attack:      st.Attack,
+	}
+}
+
+func (ut *UpdateTemplatePresenter) ToCore() core.StartTemplate {
+	return core.StartTemplate{
+		Description: ut.Description,
+		Parameters:  parametersToCore(ut.Parameters),
+		Attack:      ut.Attack,
+	}
+}
+
+func (rt *RunTemplatePresenter) ToCore(name string) core.RunTemplate {
+	return core.RunTemplate{
+		Name:       name,
+		Version:    rt.Version,
+		Parameters: rt.Parameters,
+		Overrides:  rt.Overrides,
+	}
+}
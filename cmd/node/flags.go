@@ -47,4 +47,15 @@ var cmdFlags = []cli.Flag{
 		EnvVars: []string{"GENERATOR_MAX_IDLE_CONN_TIMEOUT_SEC"},
 		Value:   60,
 	},
+	&cli.StringFlag{
+		Name:    "scenarios-dir",
+		Usage:   "directory of the declarative scenarios",
+		EnvVars: []string{"SCENARIOS_DIR"},
+	},
+	&cli.Int64Flag{
+		Name:    "stats-report-interval-sec",
+		Usage:   "stats report interval sec",
+		EnvVars: []string{"STATS_REPORT_INTERVAL_SEC"},
+		Value:   1,
+	},
 }
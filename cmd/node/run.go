@@ -3,6 +3,7 @@ package node
 import (
 	"context"
 	"load-generation-system/api/node/inject"
+	"load-generation-system/internal/scenarios"
 	"log"
 	"os"
 	"os/signal"
@@ -42,6 +43,13 @@ func run(c *cli.Context) error {
 		}
 	}()
 
+	// Declarative scenarios are advertised in the handshake along with the built-in ones
+	if dir := c.String("scenarios-dir"); dir != "" {
+		if err := scenarios.LoadDefinitions(dir); err != nil {
+			log.Fatalf("main: cannot load scenarios: %s", err.Error())
+		}
+	}
+
 	app, err := inject.InitializeNode(c, appCtx)
 	if err != nil {
 		log.Fatalf("main: cannot initialize node: %s", err.Error())
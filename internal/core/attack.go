@@ -1,17 +1,31 @@
 package core
 
 import (
+	"context"
 	"time"
 )
 
 // StartAttack represents the configuration for starting a new attack.
 // It includes details such as the attack name, wait time, duration, and configurations for different types of attack strategies.
 type StartAttack struct {
-	Name         string        // Name of the attack.
-	WaitTimeSec  float64       // Time to wait between attack executions (in seconds).
-	DurationSec  *int64        // Duration of the attack (in seconds). If nil, no duration limit.
-	ConstConfig  *ConstConfig  // Configuration for constant attack strategy.
-	LinearConfig *LinearConfig // Configuration for linear attack strategy.
+	Name              string             // Name of the attack.
+	WaitTimeSec       float64            // Time to wait between attack executions (in seconds).
+	DurationSec       *int64             // Duration of the attack (in seconds). If nil, no duration limit.
+	ConstConfig       *ConstConfig       // Configuration for constant attack strategy.
+	LinearConfig      *LinearConfig      // Configuration for linear attack strategy.
+	ArrivalRateConfig *ArrivalRateConfig // Configuration for arrival-rate attack strategy.
+	StagesConfig      *StagesConfig      // Configuration for staged attack strategy.
+	SpikeConfig       *SpikeConfig       // Configuration for spike attack strategy.
+	PeriodicConfig    *PeriodicConfig    // Configuration for periodic attack strategy.
+	TraceConfig       *TraceConfig       // Configuration for trace replay attack strategy.
+	AdaptiveConfig    *AdaptiveConfig    // Configuration for adaptive attack strategy.
+	ScheduledRun      *ScheduledRun      // The schedule run starting the attack, nil for attacks started directly.
+	Template          *TemplateRef       // The template version the attack is launched from, nil for attacks started directly.
+	Iterations        *int64             // Total number of scenario iterations run across the cluster. If nil, not bounded.
+	IterationsPerUser *int64             // Number of scenario iterations run by each user. If nil, not bounded.
+	Target            *string            // Name of the target the attack is run against. If nil, the callers use their default hosts.
+	Params            map[string]Params  // Parameters of the scenarios passed to the state of their users, indexed by scenario name.
+	Feeds             []Feed             // Datasets the users read their rows from.
 }
 
 // ConstConfig defines the configuration for a constant attack, where scenarios are run at a fixed rate.
@@ -26,37 +40,224 @@ type LinearConfig struct {
 	EndCounter      int64      // The ending counter value for the attack.
 	CounterStep     *int64     // The step increment/decrement of the counter.
 	StepIntervalSec *int64     // Interval between each step (in seconds).
+	RampDownSec     *int64     // Time to gradually retire the load before the attack ends (in seconds).
+	Scenarios       []Scenario // List of scenarios to run during the attack.
+}
+
+// ArrivalRateConfig defines the configuration for an arrival-rate (open model) attack, where scenario iterations
+// are started at a given rate regardless of how fast the target responds.
+type ArrivalRateConfig struct {
+	RampUpSec *int64         // Time to move from the start rates to the target rates (in seconds). If nil, rates are constant.
+	Scenarios []RateScenario // List of scenarios to run during the attack.
+}
+
+// RateScenario represents a scenario that is started at a given arrival rate.
+type RateScenario struct {
+	Name       string   // Name of the scenario.
+	Rate       float64  // Iterations per second at the start of the attack.
+	TargetRate *float64 // Iterations per second at the end of the ramp. If nil, the rate is constant.
+	MaxUsers   int64    // Upper bound of the users pool serving the iterations.
+}
+
+// StagesConfig defines the configuration for a staged attack, where the load moves through an ordered list of stages.
+type StagesConfig struct {
+	StartCounter int64      // The counter value the attack starts with.
+	Stages       []Stage    // Ordered list of stages to go through.
+	Scenarios    []Scenario // List of scenarios to run during the attack.
+}
+
+// Stage represents a single stage of a staged attack.
+type Stage struct {
+	TargetCounter int64    // The counter value reached by the end of the stage.
+	DurationSec   int64    // Duration of the stage (in seconds).
+	Ramp          RampType // The way the counter moves to the target value.
+}
+
+// RampType defines how the counter of a stage moves to its target value.
+type RampType string
+
+const (
+	RampLinear RampType = "linear" // The counter changes evenly over the whole stage.
+	RampStep   RampType = "step"   // The counter jumps to the target value at the start of the stage.
+)
+
+// SpikeConfig defines the configuration for a spike attack, where short bursts of users are added on top
+// of a constant baseline load.
+type SpikeConfig struct {
+	BaselineCounter  int64      // The counter value kept during the whole attack.
+	SpikeCounter     int64      // The counter value added on top of the baseline during a spike.
+	SpikeDurationSec int64      // Duration of each spike (in seconds).
+	SpikeCount       int64      // Number of spikes to run.
+	IntervalSec      int64      // Time between the end of a spike and the start of the next one (in seconds).
+	Scenarios        []Scenario // List of scenarios to run during the attack.
+}
+
+// SpikeDetails describes a single spike of a spike attack.
+type SpikeDetails struct {
+	IncrementID int64      // ID of the increment holding the spike users.
+	StartedAt   time.Time  // Time when the spike started.
+	EndedAt     *time.Time // Time when the spike ended. If nil, the spike is still running.
+}
+
+// PeriodicConfig defines the configuration for a periodic attack, where the counter follows a repeating curve
+// between its minimum and maximum values.
+type PeriodicConfig struct {
+	MinCounter        int64      // The counter value at the bottom of the curve.
+	MaxCounter        int64      // The counter value at the top of the curve.
+	PeriodSec         int64      // Length of a single period of the curve (in seconds).
+	PhaseSec          int64      // Shift of the curve start within the period (in seconds).
+	SampleIntervalSec int64      // Interval between the counter updates (in seconds).
+	Points            []float64  // Evenly spaced curve levels within a period, from 0 (min) to 1 (max). If empty, the curve is a sine.
+	Scenarios         []Scenario // List of scenarios to run during the attack.
+}
+
+// TraceConfig defines the configuration for a trace replay attack, where the load follows a recorded time series.
+type TraceConfig struct {
+	Mode            LoadMode     // Meaning of the series values.
+	TimeCompression float64      // Factor the series is sped up by, 1 replays it in real time.
+	MaxUsers        int64        // Upper bound of the users pool of each scenario in the RPS mode.
+	Points          []TracePoint // Points of the series ordered by their offsets.
+	Scenarios       []Scenario   // List of scenarios to run during the attack.
+}
+
+// TracePoint represents a single point of a recorded time series.
+// Each point holds its values until the next point is reached.
+type TracePoint struct {
+	OffsetSec float64            // Offset of the point from the start of the series (in seconds).
+	Values    map[string]float64 // A map of scenarios with their values at this point.
+}
+
+// LoadMode defines what the load values of a trace or an adaptive attack mean.
+type LoadMode string
+
+const (
+	LoadUsers LoadMode = "users" // The values are concurrent users.
+	LoadRPS   LoadMode = "rps"   // The values are iterations started per second.
+)
+
+// AdaptiveConfig defines the configuration for an adaptive attack, which searches for the highest load
+// the target sustains while its p95 latency and error rate stay under the thresholds.
+type AdaptiveConfig struct {
+	Mode            LoadMode   // Meaning of the load values.
+	StartValue      int64      // The load value the search starts with.
+	StepValue       int64      // The load value added after every successful step.
+	MaxValue        *int64     // Upper bound of the load value. If nil, the load is not bounded.
+	Resolution      int64      // The search is over once the gap between sustainable and failed loads is not above it.
+	StepIntervalSec int64      // Time each load value is observed for (in seconds).
+	MaxP95Ms        float64    // The highest acceptable p95 latency (in milliseconds).
+	MaxErrorRate    float64    // The highest acceptable share of failed requests, from 0 to 1.
+	MaxUsers        int64      // Upper bound of the users pool of each scenario in the RPS mode.
 	Scenarios       []Scenario // List of scenarios to run during the attack.
 }
 
 // ScenarioDetails contains metadata about a scenario, including its name and description.
 type ScenarioDetails struct {
-	Name        string // Name of the scenario.
-	Description string // Description of the scenario.
+	Name        string              // Name of the scenario.
+	Description string              // Description of the scenario.
+	Version     int64               // Version of an uploaded scenario. Zero for the scenarios shipped with the nodes.
+	Parameters  []ScenarioParameter // Schema of the parameters the scenario accepts.
 }
 
+// ParameterType defines the type of the value of a scenario parameter.
+type ParameterType string
+
+const (
+	ParameterString ParameterType = "string" // The value is a string.
+	ParameterInt    ParameterType = "int"    // The value is an int64.
+	ParameterFloat  ParameterType = "float"  // The value is a float64.
+	ParameterBool   ParameterType = "bool"   // The value is a bool.
+)
+
+// ScenarioParameter describes a parameter a scenario reads from the state of its users.
+type ScenarioParameter struct {
+	Name        string        // Name of the parameter.
+	Type        ParameterType // Type of the parameter value.
+	Description string        // Description of the parameter.
+	Required    bool          // Whether the attacks must set the parameter.
+	Default     any           // Value of the parameter when an attack does not set it. If nil, the parameter is left unset.
+}
+
+// Params holds the values of the parameters of a scenario, indexed by parameter name.
+type Params map[string]any
+
 // IncrementDetails provides details about an increment in the attack, such as the increment ID and associated scenarios.
 type IncrementDetails struct {
-	ID        int64            // Unique ID for the increment.
-	AttackID  int64            // ID of the attack that this increment belongs to.
-	Scenarios map[string]int64 // A map of scenarios with their respective counters.
+	ID                  int64                  // Unique ID for the increment.
+	AttackID            int64                  // ID of the attack that this increment belongs to.
+	Scenarios           map[string]int64       // A map of scenarios with their respective counters.
+	ArrivalRates        map[string]ArrivalRate // A map of open model scenarios with their arrival rates.
+	Pacings             map[string]Pacing      // A map of paced scenarios with their pacing.
+	Iterations          *int64                 // Number of iterations the increment runs. If nil, not bounded.
+	IterationsPerUser   int64                  // Number of iterations each user of the increment runs. Zero means not bounded.
+	CompletedIterations int64                  // Number of iterations completed by the nodes that have finished the increment.
+	TTLSec              *int64                 // Time to live of the increment (in seconds). If nil, it runs until stopped.
+	ExpiresAt           *time.Time             // Time when the increment is stopped by its time to live, pushed back by the pauses.
+	Feeds               []FeedShare            // Dataset rows a node has received with the increment, handed over when the node is lost.
 }
 
 // AttackDetails contains all the details about an attack, including the configuration and its increments.
 type AttackDetails struct {
-	ID           int64              // Unique ID of the attack.
-	Name         string             // Name of the attack.
-	WaitTimeSec  float64            // Wait time before starting the attack.
-	CreatedAt    time.Time          // Time when the attack was created.
-	DurationSec  *int64             // Duration for the attack (in seconds).
-	ConstConfig  *ConstConfig       // Constant attack configuration.
-	LinearConfig *LinearConfig      // Linear attack configuration.
-	Increments   []IncrementDetails // List of increments associated with the attack.
+	ID                int64              // Unique ID of the attack.
+	Name              string             // Name of the attack.
+	WaitTimeSec       float64            // Wait time before starting the attack.
+	CreatedAt         time.Time          // Time when the attack was created.
+	DurationSec       *int64             // Duration for the attack (in seconds).
+	ConstConfig       *ConstConfig       // Constant attack configuration.
+	LinearConfig      *LinearConfig      // Linear attack configuration.
+	ArrivalRateConfig *ArrivalRateConfig // Arrival-rate attack configuration.
+	StagesConfig      *StagesConfig      // Staged attack configuration.
+	ActiveStage       *int               // Index of the currently active stage of a staged attack.
+	SpikeConfig       *SpikeConfig       // Spike attack configuration.
+	Spikes            []SpikeDetails     // List of spikes started by a spike attack.
+	PeriodicConfig    *PeriodicConfig    // Periodic attack configuration.
+	TargetCounter     *int64             // The counter value currently targeted by a periodic attack.
+	TraceConfig       *TraceConfig       // Trace replay attack configuration.
+	ActivePoint       *int               // Index of the currently replayed point of a trace attack.
+	AdaptiveConfig    *AdaptiveConfig    // Adaptive attack configuration.
+	SustainableLoad   *int64             // The highest load value an adaptive attack has found sustainable so far.
+	Converged         bool               // Whether an adaptive attack has finished its search.
+	ScheduledRun      *ScheduledRun      // The schedule run that created the attack.
+	Template          *TemplateRef       // The template version the attack was launched from.
+	Iterations        *int64             // Total number of scenario iterations run across the cluster.
+	IterationsPerUser *int64             // Number of scenario iterations run by each user.
+	Target            *Target            // Copy of the target the attack is run against, taken at its start.
+	Params            map[string]Params  // Parameters of the scenarios with their defaults applied, indexed by scenario name.
+	Feeds             []Feed             // Datasets the users read their rows from.
+	Paused            bool               // Whether the attack is paused.
+	PausedAt          *time.Time         // Time when the attack was paused, nil if it runs.
+	Increments        []IncrementDetails // List of increments associated with the attack.
+}
+
+// AttackSummary describes the outcome of an ended attack, based on the request statistics reported by the nodes.
+type AttackSummary struct {
+	AttackID      int64                 // ID of the attack.
+	EndedAt       time.Time             // Time when the attack ended.
+	Requests      int64                 // Number of processed requests.
+	Errors        int64                 // Number of failed requests.
+	ErrorRate     float64               // Share of failed requests, from 0 to 1.
+	P95Ms         float64               // Estimated p95 latency (in milliseconds).
+	Checks        map[string]CheckStats // Results of the checks of the responses, indexed by check name.
+	SetupFailures int64                 // Number of failed setups of the users and of the attack.
+}
+
+// StopMode defines how the running iterations are treated when an attack or an increment is stopped.
+type StopMode string
+
+const (
+	StopImmediate StopMode = "immediate" // The running iterations are cancelled right away.
+	StopGraceful  StopMode = "graceful"  // The running iterations are given the drain timeout to finish.
+)
+
+// StopOptions defines how an attack or an increment is stopped. The zero value stops it immediately.
+type StopOptions struct {
+	Mode            StopMode // The stop mode.
+	DrainTimeoutSec int64    // Time the running iterations are given to finish in the graceful mode (in seconds).
 }
 
 // NodeDetails contains details about a node, including its name, whether it's active, and the scenarios it can run.
 type NodeDetails struct {
 	Name      string            // Name of the node.
+	Instance  string            // ID of the node process, changed by every restart of the node.
 	IsActive  bool              // Indicates whether the node is active.
 	Scenarios []ScenarioDetails // List of scenarios available for the node.
 	Attacks   []AttackDetails   // List of attacks assigned to the node.
@@ -64,8 +265,27 @@ type NodeDetails struct {
 
 // Scenario represents an individual scenario that can be executed during an attack.
 type Scenario struct {
-	Name    string // Name of the scenario.
-	Counter *int64 // A counter that tracks the number of times the scenario has been executed.
+	Name    string  // Name of the scenario.
+	Counter *int64  // A counter that tracks the number of times the scenario has been executed.
+	Pacing  *Pacing // The way the users of the scenario space their iterations. If nil, they follow the attack wait time.
+}
+
+// PacingMode defines how the users of a scenario space their iterations.
+type PacingMode string
+
+const (
+	PacingFixed            PacingMode = "fixed"             // Iterations start at a fixed interval from each other.
+	PacingUniformThink     PacingMode = "uniform_think"     // A uniformly distributed think time passes between iterations.
+	PacingExponentialThink PacingMode = "exponential_think" // An exponentially distributed think time passes between iterations.
+	PacingPoisson          PacingMode = "poisson"           // Iterations of each user start as a Poisson arrival process.
+)
+
+// Pacing describes how each user of a scenario spaces its iterations on its own, independently of the other users.
+type Pacing struct {
+	Mode        PacingMode // The pacing model.
+	IntervalSec float64    // Fixed interval, mean think time or mean interval between the arrivals (in seconds).
+	MinSec      float64    // Lower bound of a uniform think time (in seconds).
+	MaxSec      float64    // Upper bound of a uniform think time (in seconds).
 }
 
 // AttackService defines the operations available for managing and controlling attacks. It includes methods for
@@ -74,14 +294,37 @@ type AttackService interface {
 	// StartAttack starts a new attack based on the provided configuration.
 	StartAttack(start StartAttack) (AttackDetails, error)
 
+	// StartAndWaitAttack starts a new attack and returns a channel receiving its summary once it ends.
+	// The channel is subscribed before the attack can end.
+	StartAndWaitAttack(start StartAttack) (AttackDetails, <-chan AttackSummary, error)
+
 	// StartIncrement starts a new increment for the given operation start configuration.
-	StartIncrement(start OperationStart) (IncrementDetails, error)
+	// An increment with a time to live is stopped once it elapses.
+	StartIncrement(start OperationStart, ttlSec *int64) (IncrementDetails, error)
 
 	// StopAttack stops the attack with the specified ID.
-	StopAttack(attackID int64) error
+	StopAttack(attackID int64, options StopOptions) error
 
 	// StopIncrement stops the increment for the specified attack and increment IDs.
-	StopIncrement(attackID, incrementID int64) error
+	StopIncrement(attackID, incrementID int64, options StopOptions) error
+
+	// ScaleAttack sets new total counters of the attack scenarios, adding or retiring users inside the existing increments.
+	ScaleAttack(attackID int64, scenarios map[string]int64) (AttackDetails, error)
+
+	// ScaleIncrement sets new counters of the increment scenarios, adding or retiring users inside the increment.
+	ScaleIncrement(attackID, incrementID int64, scenarios map[string]int64) (IncrementDetails, error)
+
+	// PauseAttack suspends the attack with the specified ID, keeping its users and increments.
+	PauseAttack(attackID int64) error
+
+	// ResumeAttack continues the paused attack with the specified ID.
+	ResumeAttack(attackID int64) error
+
+	// WaitAttack returns a channel receiving the summary of the attack with the specified ID once it ends.
+	WaitAttack(attackID int64) (<-chan AttackSummary, error)
+
+	// GetSummary retrieves the summary of a recently ended attack with the specified ID.
+	GetSummary(attackID int64) (AttackSummary, error)
 
 	// GetAttacks retrieves a list of all the current attacks.
 	GetAttacks() []AttackDetails
@@ -89,9 +332,25 @@ type AttackService interface {
 	// GetScenarios retrieves a list of all available scenarios.
 	GetScenarios() []ScenarioDetails
 
+	// UploadScenario registers a declarative scenario on every node, as a new version if it was uploaded before.
+	UploadScenario(upload OperationScenario) (ScenarioDetails, error)
+
 	// ListNodes retrieves a list of all nodes in the system.
 	ListNodes() []NodeDetails
 
+	// ReportStats accepts the request statistics of the attacks running on a node.
+	ReportStats(stats []AttackStats)
+
+	// CompleteIncrement accepts the report of a node that has run all the iterations of an increment.
+	CompleteIncrement(nodeName string, completion IncrementCompletion)
+
+	// ClaimSetup lets the first node claiming the attack-level setup of a scenario run it,
+	// and makes the other nodes wait for its outcome.
+	ClaimSetup(ctx context.Context, nodeName string, attackID int64, scenario string) (bool, error)
+
+	// CompleteSetup accepts the outcome of the attack-level setup of a scenario run by a node, empty if it succeeded.
+	CompleteSetup(nodeName string, attackID int64, scenario, failure string) error
+
 	// AddNode adds a new node to the system.
 	AddNode(node Node) error
 
@@ -2,27 +2,86 @@ package core
 
 import "context"
 
-// Operation represents a unit of work that can either be started, stopped, or killed.
+// Operation represents a unit of work that can either be started, stopped, reduced, rescaled, paused, resumed or killed,
+// or a scenario to register on the node.
 type Operation struct {
-	Start *OperationStart // Represents the operation to start an attack.
-	Stop  *OperationStop  // Represents the operation to stop an attack.
-	Kill  *OperationKill  // Represents the operation to kill an attack.
+	Start    *OperationStart    // Represents the operation to start an attack.
+	Stop     *OperationStop     // Represents the operation to stop an attack.
+	Reduce   *OperationReduce   // Represents the operation to reduce an increment.
+	Rescale  *OperationRescale  // Represents the operation to change the arrival rates of an increment.
+	Pause    *OperationPause    // Represents the operation to pause an attack.
+	Resume   *OperationResume   // Represents the operation to resume an attack.
+	Kill     *OperationKill     // Represents the operation to kill an attack.
+	Scenario *OperationScenario // Represents the operation to register an uploaded scenario.
 }
 
 // OperationStart contains the details required to start an attack operation.
 // It includes the attack ID, increment ID, wait time before starting, and the scenarios to be executed.
 type OperationStart struct {
-	ID          string           // Unique identifier for this operation.
-	AttackID    int64            // ID of the attack to start.
-	IncrementID int64            // ID of the increment to start.
-	WaitTimeSec float64          // Time (in seconds) to wait before starting the operation.
-	Scenarios   map[string]int64 // A map of scenario names and their respective counters.
+	ID                string                 // Unique identifier for this operation.
+	AttackID          int64                  // ID of the attack to start.
+	IncrementID       int64                  // ID of the increment to start.
+	WaitTimeSec       float64                // Time (in seconds) to wait before starting the operation.
+	Scenarios         map[string]int64       // A map of scenario names and their respective counters.
+	ArrivalRates      map[string]ArrivalRate // A map of open model scenario names and their arrival rates.
+	Pacings           map[string]Pacing      // A map of paced scenario names and their pacing.
+	Iterations        *int64                 // Number of iterations the users of the operation run in total. If nil, not bounded.
+	IterationsPerUser int64                  // Number of iterations each user of the operation runs. Zero means not bounded.
+	Target            *Target                // The target the users call. If nil, the callers use their default hosts.
+	Params            map[string]Params      // Parameters of the scenarios passed to the state of their users, indexed by scenario name.
+	Feeds             []FeedShare            // Dataset rows the node receives for the users of the attack.
+}
+
+// ArrivalRate describes how often iterations of an open model scenario are started.
+// The counter of such a scenario bounds the pool of users serving its iterations.
+type ArrivalRate struct {
+	Rate       float64 // Iterations per second at the start of the increment.
+	TargetRate float64 // Iterations per second at the end of the ramp.
+	RampUpSec  int64   // Duration of the ramp from Rate to TargetRate (in seconds). Zero means a constant rate.
 }
 
 // OperationStop represents the operation to stop an attack or an increment.
 type OperationStop struct {
-	AttackID    int64  // ID of the attack to stop.
-	IncrementID *int64 // ID of the increment to stop (optional).
+	AttackID        int64  // ID of the attack to stop.
+	IncrementID     *int64 // ID of the increment to stop (optional).
+	DrainTimeoutSec *int64 // Time the running iterations are given to finish (in seconds). If nil, they are cancelled right away.
+}
+
+// OperationReduce represents the operation to retire part of the users of an increment.
+// The newest users of each scenario are retired first.
+type OperationReduce struct {
+	AttackID    int64            // ID of the attack to reduce.
+	IncrementID int64            // ID of the increment to reduce.
+	Scenarios   map[string]int64 // A map of scenario names and the amount of users to retire.
+}
+
+// OperationRescale represents the operation to change the arrival rates of the open model scenarios of an increment.
+// The users pools serving the rates are kept, along with their users.
+type OperationRescale struct {
+	AttackID     int64                  // ID of the attack to rescale.
+	IncrementID  int64                  // ID of the increment to rescale.
+	ArrivalRates map[string]ArrivalRate // A map of open model scenario names and their new arrival rates.
+}
+
+// OperationPause represents the operation to suspend the iterations of an attack.
+// The users of the attack are kept along with their state.
+type OperationPause struct {
+	AttackID int64 // ID of the attack to pause.
+}
+
+// OperationResume represents the operation to continue the iterations of a paused attack.
+type OperationResume struct {
+	AttackID int64 // ID of the attack to resume.
+}
+
+// OperationScenario represents the operation to register an uploaded declarative scenario on a node.
+// A newer version of the scenario replaces the previous one for the users created afterwards.
+type OperationScenario struct {
+	Name        string              // Name of the scenario.
+	Description string              // Description of the scenario.
+	Version     int64               // Version of the scenario, assigned by the manager.
+	Parameters  []ScenarioParameter // Schema of the parameters of the scenario.
+	Definition  []byte              // JSON definition of the scenario.
 }
 
 // OperationKill represents an operation to immediately kill a node.
@@ -30,6 +89,24 @@ type OperationKill struct {
 	// No fields necessary for killing a node, as this operation is an immediate termination.
 }
 
+// AttackStats holds the request statistics of an attack collected by a node over a period of time.
+type AttackStats struct {
+	AttackID      int64                 // ID of the attack the requests belong to.
+	Requests      int64                 // Number of processed requests.
+	Errors        int64                 // Number of failed requests.
+	Bounds        []float64             // Upper bounds of the latency buckets (in seconds).
+	Buckets       []int64               // Number of requests per latency bucket, the last one counts requests above all bounds.
+	Checks        map[string]CheckStats // Results of the checks of the responses, indexed by check name.
+	SetupFailures int64                 // Number of failed setups of the users and of the attack, their requests are not counted above.
+}
+
+// IncrementCompletion reports that a node has run all the iterations of an increment it was given.
+type IncrementCompletion struct {
+	AttackID    int64 // ID of the attack the increment belongs to.
+	IncrementID int64 // ID of the completed increment.
+	Iterations  int64 // Number of iterations completed by the node.
+}
+
 // Node represents a unit of execution that can manage and perform operations on attacks.
 // Each node can start attacks, stop attacks, and acknowledge operations.
 type Node interface {
@@ -42,6 +119,21 @@ type Node interface {
 	// StopAttack stops an attack or increment based on the provided stop details.
 	StopAttack(stop OperationStop) error
 
+	// ReduceAttack retires part of the users of an increment based on the provided reduce details.
+	ReduceAttack(reduce OperationReduce) error
+
+	// RescaleAttack changes the arrival rates of an increment based on the provided rescale details.
+	RescaleAttack(rescale OperationRescale) error
+
+	// PauseAttack suspends the iterations of an attack based on the provided pause details.
+	PauseAttack(pause OperationPause) error
+
+	// ResumeAttack continues the iterations of a paused attack based on the provided resume details.
+	ResumeAttack(resume OperationResume) error
+
+	// AddScenario registers an uploaded scenario on the node, replacing its previous version.
+	AddScenario(scenario OperationScenario) error
+
 	// GetDetails retrieves the current details of the node, including scenarios and attacks.
 	GetDetails() NodeDetails
 
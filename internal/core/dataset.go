@@ -0,0 +1,82 @@
+package core
+
+import "time"
+
+// DatasetFormat defines the format a dataset is uploaded in.
+type DatasetFormat string
+
+const (
+	DatasetCSV   DatasetFormat = "csv"   // Comma separated values with a header row naming the columns.
+	DatasetJSONL DatasetFormat = "jsonl" // One JSON object per line.
+)
+
+// DataRow is a row of a dataset, holding its values indexed by column name.
+type DataRow map[string]string
+
+// Dataset holds the rows the users of the attacks read their inputs from, such as account logins or product IDs.
+type Dataset struct {
+	Name    string        // Name of the dataset.
+	Format  DatasetFormat // Format the dataset was uploaded in.
+	Columns []string      // Names of the columns of the dataset.
+	Rows    []DataRow     // Rows of the dataset.
+}
+
+// DatasetDetails describes an uploaded dataset without its rows.
+type DatasetDetails struct {
+	Name       string        // Name of the dataset.
+	Format     DatasetFormat // Format the dataset was uploaded in.
+	Columns    []string      // Names of the columns of the dataset.
+	RowCount   int           // Number of rows of the dataset.
+	UploadedAt time.Time     // Time when the dataset was uploaded.
+}
+
+// FeedMode defines how the rows of a dataset are handed out to the users of an attack.
+type FeedMode string
+
+const (
+	FeedSequentialUnique FeedMode = "sequential_unique" // Rows are handed out in order, each of them once across the cluster.
+	FeedCircular         FeedMode = "circular"          // Rows are handed out in order, starting over once all of them are used.
+	FeedRandom           FeedMode = "random"            // Rows are picked at random, every node holds the whole dataset.
+)
+
+// Feed attaches a dataset to an attack.
+type Feed struct {
+	Dataset string   // Name of the dataset.
+	Mode    FeedMode // The way the rows are handed out.
+}
+
+// FeedShare holds the rows of a dataset a node hands out to its users.
+type FeedShare struct {
+	Dataset string    // Name of the dataset.
+	Mode    FeedMode  // The way the rows are handed out.
+	Rows    []DataRow // Rows given to the node.
+}
+
+// Feeder hands out the rows of the datasets attached to an attack to its users.
+type Feeder interface {
+	// Next returns the next row of the dataset for a user.
+	//
+	// Parameters:
+	//   - dataset: Name of the dataset
+	//
+	// Returns:
+	//   - DataRow: The row to use
+	//   - error: ErrDatasetNotFound if the dataset is not attached to the attack,
+	//     ErrFeedExhausted if the node has handed out all its unique rows
+	Next(dataset string) (DataRow, error)
+}
+
+// DatasetService defines the operations available for managing the datasets.
+type DatasetService interface {
+	// UploadDataset stores the dataset, replacing the one with the same name.
+	UploadDataset(dataset Dataset) (DatasetDetails, error)
+
+	// GetDataset retrieves the dataset with the specified name along with its rows.
+	GetDataset(name string) (Dataset, error)
+
+	// GetDatasets retrieves the details of all the datasets.
+	GetDatasets() []DatasetDetails
+
+	// DeleteDataset removes the dataset with the specified name. Running attacks keep their rows.
+	DeleteDataset(name string) error
+}
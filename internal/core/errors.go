@@ -7,14 +7,38 @@ var (
 	ErrAttackNotFound    = errors.New("attack not found")
 	ErrIncrementNotFound = errors.New("increment not found")
 	ErrScenarioNotFound  = errors.New("scenario not found")
+	ErrScenarioExists    = errors.New("scenario already exists")
 	ErrEmptyAttack       = errors.New("empty attack configuration")
 	ErrBadConfig         = errors.New("bad attack configuration")
 	ErrNodeAlreadyExists = errors.New("node already exists")
+	ErrScheduleNotFound  = errors.New("schedule not found")
+	ErrAttackPaused      = errors.New("attack is paused")
+	ErrAttackNotPaused   = errors.New("attack is not paused")
+	ErrAttackBounded     = errors.New("attack runs a fixed number of iterations")
+	ErrAttackRunning     = errors.New("attack is still running")
+	ErrTemplateNotFound  = errors.New("template not found")
+	ErrTemplateExists    = errors.New("template already exists")
+	ErrTemplateParameter = errors.New("bad template parameter")
+	ErrSuiteNotFound     = errors.New("suite not found")
+	ErrSuiteEnded        = errors.New("suite has already ended")
+	ErrTargetNotFound    = errors.New("target not found")
+	ErrBadParams         = errors.New("bad scenario parameters")
+	ErrDatasetNotFound   = errors.New("dataset not found")
+	ErrBadDataset        = errors.New("bad dataset")
+	ErrLeasePoolNotFound = errors.New("lease pool not found")
+	ErrBadLeasePool      = errors.New("bad lease pool")
+	ErrLeaseNotFound     = errors.New("lease not found")
+	ErrSetupFailed       = errors.New("attack setup failed")
 
 	ErrJobNotFound     = errors.New("job not found")
 	ErrBrokenScheduler = errors.New("cannot schedule report job")
 
 	ErrUnacceptableCode = errors.New("unacceptable status code")
+	ErrExtractionFailed = errors.New("response extraction failed")
+	ErrUnknownVariable  = errors.New("unknown template variable")
 
 	ErrScenarioExecutionViolation = errors.New("scenario execution violation")
+	ErrBadScenario                = errors.New("bad scenario definition")
+	ErrCheckFailed                = errors.New("response check failed")
+	ErrFeedExhausted              = errors.New("dataset rows exhausted")
 )
@@ -4,7 +4,8 @@ package core
 // It contains a map of parameters (`Params`) that can hold various dynamic configuration values.
 // The parameters can be of any type (`any`), providing flexibility for different types of configurations.
 type State struct {
-	Params map[string]any // A map containing configuration parameters as key-value pairs.
+	Params map[string]any   // A map containing configuration parameters as key-value pairs.
+	Leases map[string]Lease // Leases held by the user, indexed by pool name.
 }
 
 // LoadGenerator is an interface that defines the operations needed to manage the lifecycle of a load generation process.
@@ -30,6 +31,52 @@ type LoadGenerator interface {
 	//   - An error if the attack could not be stopped; otherwise, nil.
 	StopAttack(stop OperationStop) error
 
+	// ReduceAttack retires part of the users of a running increment for a given `OperationReduce` configuration.
+	// The rest of the increment users keep running.
+	//
+	// Parameters:
+	//   - reduce: An `OperationReduce` struct that contains details about the users to retire.
+	//
+	// Returns:
+	//   - An error if the increment could not be reduced; otherwise, nil.
+	ReduceAttack(reduce OperationReduce) error
+
+	// RescaleAttack changes the arrival rates of a running increment for a given `OperationRescale` configuration.
+	// The users pools of the increment keep running with their users.
+	//
+	// Parameters:
+	//   - rescale: An `OperationRescale` struct that contains details about the new arrival rates.
+	//
+	// Returns:
+	//   - An error if the increment could not be rescaled; otherwise, nil.
+	RescaleAttack(rescale OperationRescale) error
+
+	// PauseAttack suspends the iterations of an attack for a given `OperationPause` configuration.
+	// The users of the attack keep their state and no new iterations are started until the attack is resumed.
+	//
+	// Parameters:
+	//   - pause: An `OperationPause` struct that contains details about the attack to pause.
+	//
+	// Returns:
+	//   - An error if the attack could not be paused; otherwise, nil.
+	PauseAttack(pause OperationPause) error
+
+	// ResumeAttack continues the iterations of a paused attack for a given `OperationResume` configuration.
+	//
+	// Parameters:
+	//   - resume: An `OperationResume` struct that contains details about the attack to resume.
+	//
+	// Returns:
+	//   - An error if the attack could not be resumed; otherwise, nil.
+	ResumeAttack(resume OperationResume) error
+
+	// Completions returns a channel receiving the increments whose iterations have all been run.
+	// The increments keep their users until they are stopped.
+	//
+	// Returns:
+	//   - A channel of `IncrementCompletion` reports.
+	Completions() <-chan IncrementCompletion
+
 	// Stop terminates the load generator itself, stopping any ongoing operations.
 	// This is typically used to gracefully shut down the load generation process.
 	Stop()
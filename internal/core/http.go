@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 // Client defines an interface for making HTTP requests. It provides methods to initiate a
@@ -84,6 +85,39 @@ type Request interface {
 	//   - The updated Request object, allowing for method chaining.
 	SetPath(format string, a ...any) Request
 
+	// SetState binds the request to the state of a user. When the request is sent, the {{name}} references
+	// in its path, headers, query parameters and body are replaced with the user parameters,
+	// and the values taken by its extractors are stored in the user parameters.
+	//
+	// Parameters:
+	//   - state: The state of the user.
+	//
+	// Returns:
+	//   - The updated Request object, allowing for method chaining.
+	SetState(state *State) Request
+
+	// Extract adds extractors taking values out of the response of the request. If one of them
+	// finds no value, sending the request returns ErrExtractionFailed.
+	//
+	// Parameters:
+	//   - extractors: The extractors to run on the response.
+	//
+	// Returns:
+	//   - The updated Request object, allowing for method chaining.
+	Extract(extractors ...Extractor) Request
+
+	// Check adds checks the response of the request must pass. The results of the checks are counted
+	// per check name, and a failed check in the fail mode makes sending the request return ErrCheckFailed.
+	// A request with a check on the status code accepts any status allowed by its checks
+	// instead of requiring a status below 400.
+	//
+	// Parameters:
+	//   - checks: The checks to run on the response.
+	//
+	// Returns:
+	//   - The updated Request object, allowing for method chaining.
+	Check(checks ...Check) Request
+
 	// Get sends a GET request to the server with the specified configuration.
 	// It retrieves data from the server.
 	//
@@ -136,10 +170,138 @@ type Request interface {
 }
 
 // Response defines an interface for the server's response to an HTTP request.
-// It provides a method to access the raw body of the response.
+// It provides methods to access the status, headers and raw body of the response,
+// and to take values out of it.
 type Response interface {
 	// Body retrieves the raw body content of the server's response.
 	// It returns the body as a slice of bytes, which can be processed further
 	// as needed, such as deserialization into a specific data structure.
 	Body() []byte
+
+	// StatusCode retrieves the HTTP status code of the server's response.
+	StatusCode() int
+
+	// Header retrieves the first value of a header of the server's response.
+	//
+	// Parameters:
+	//   - header: The name of the header.
+	//
+	// Returns:
+	//   - The value of the header, empty if the response has no such header.
+	Header(header string) string
+
+	// Cookie retrieves the value of a cookie set by the server's response.
+	//
+	// Parameters:
+	//   - name: The name of the cookie.
+	//
+	// Returns:
+	//   - The value of the cookie.
+	//   - false if the response does not set the cookie.
+	Cookie(name string) (string, bool)
+
+	// Extract takes values out of the response.
+	//
+	// Parameters:
+	//   - extractors: The extractors to run on the response.
+	//
+	// Returns:
+	//   - The extracted values indexed by extractor name.
+	//   - ErrExtractionFailed if an extractor is invalid or finds no value.
+	Extract(extractors ...Extractor) (map[string]string, error)
+}
+
+// ExtractorKind defines where an extractor takes its value from in a response.
+type ExtractorKind string
+
+const (
+	ExtractorJSONPath ExtractorKind = "jsonpath" // JSONPath expression on the JSON body, such as $.data.items[0].id.
+	ExtractorRegex    ExtractorKind = "regex"    // Regular expression on the body, taking its first group or else its whole match.
+	ExtractorHeader   ExtractorKind = "header"   // Response header, by name.
+	ExtractorCookie   ExtractorKind = "cookie"   // Cookie set by the response, by name.
+)
+
+// Extractor takes a value out of a response, such as a token or an ID needed by the next requests of a user.
+// Values other than strings found by a JSONPath expression are taken in their JSON form.
+type Extractor struct {
+	Name       string        // Name of the value, and of the user parameter it is stored in.
+	Kind       ExtractorKind // Where the value is taken from.
+	Expression string        // JSONPath expression, regular expression, header name or cookie name.
+}
+
+// ExtractJSONPath creates an extractor taking a value out of the JSON body of a response.
+// The expression supports child names, such as $.data.token or $['data']['token'], and array indexes,
+// negative ones counting from the end, such as $.items[0].id or $.items[-1].id.
+func ExtractJSONPath(name, path string) Extractor {
+	return Extractor{Name: name, Kind: ExtractorJSONPath, Expression: path}
+}
+
+// ExtractRegex creates an extractor taking the first group, or else the whole match, of a regular expression
+// out of the body of a response.
+func ExtractRegex(name, expression string) Extractor {
+	return Extractor{Name: name, Kind: ExtractorRegex, Expression: expression}
+}
+
+// ExtractHeader creates an extractor taking the value of a header of a response.
+func ExtractHeader(name, header string) Extractor {
+	return Extractor{Name: name, Kind: ExtractorHeader, Expression: header}
+}
+
+// ExtractCookie creates an extractor taking the value of a cookie set by a response.
+func ExtractCookie(name, cookie string) Extractor {
+	return Extractor{Name: name, Kind: ExtractorCookie, Expression: cookie}
+}
+
+// CheckMode defines how a failed check affects the iteration of the user.
+type CheckMode string
+
+const (
+	CheckFail   CheckMode = "fail"   // The failed check fails the request, and so the iteration.
+	CheckRecord CheckMode = "record" // The failed check is only recorded.
+)
+
+// Check is an assertion on the response of a request. Its results are counted under its name,
+// both in the metrics of the node and in the summary of the attack. A check passes when all
+// of its assertions hold, the assertions left at their zero value are not checked.
+type Check struct {
+	Name         string        // Name of the check, its results are counted under.
+	Mode         CheckMode     // Whether a failure fails the iteration or is only recorded, CheckFail if empty.
+	Statuses     []int         // Status codes the response may have.
+	BodyContains string        // Text the response body must contain.
+	JSONPath     string        // JSONPath expression of a value the JSON response body must hold.
+	Equals       *string       // Expected value at JSONPath, values other than strings in their JSON form.
+	JSONSchema   []byte        // JSON schema the JSON response body must conform to.
+	MaxLatency   time.Duration // Latency budget of the request, from sending it to reading the whole response.
+}
+
+// CheckStatus creates a check on the status code of a response.
+func CheckStatus(name string, statuses ...int) Check {
+	return Check{Name: name, Statuses: statuses}
+}
+
+// CheckBodyContains creates a check on a text the body of a response must contain.
+func CheckBodyContains(name, text string) Check {
+	return Check{Name: name, BodyContains: text}
+}
+
+// CheckJSONSchema creates a check on the JSON body of a response conforming to a JSON schema.
+func CheckJSONSchema(name string, schema []byte) Check {
+	return Check{Name: name, JSONSchema: schema}
+}
+
+// CheckLatency creates a check on the latency of a request.
+func CheckLatency(name string, budget time.Duration) Check {
+	return Check{Name: name, MaxLatency: budget}
+}
+
+// RecordOnly returns a copy of the check whose failures are only recorded, without failing the iteration.
+func (c Check) RecordOnly() Check {
+	c.Mode = CheckRecord
+	return c
+}
+
+// CheckStats counts the results of a check.
+type CheckStats struct {
+	Passed int64 // Number of passed checks.
+	Failed int64 // Number of failed checks.
 }
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// LeasePool holds resources that cannot be used by two users at once, such as test accounts,
+// tenants or device IDs. The pool is held by the manager and shared by the users of every node.
+type LeasePool struct {
+	Name      string   // Name of the pool.
+	Resources []string // Resources of the pool.
+}
+
+// Lease grants a user the exclusive use of a resource of a pool until it is released.
+type Lease struct {
+	ID         string    // Unique identifier of the lease.
+	Pool       string    // Name of the pool the resource belongs to.
+	Resource   string    // The leased resource.
+	Node       string    // Name of the node of the user holding the lease.
+	Instance   string    // ID of the node process of the user holding the lease.
+	AcquiredAt time.Time // Time when the lease was granted.
+}
+
+// LeasePoolDetails describes a lease pool along with its current leases.
+type LeasePoolDetails struct {
+	LeasePool           // The pool configuration.
+	Leases    []Lease   // Leases currently held on the resources of the pool.
+	Waiting   int       // Number of users waiting for a resource of the pool.
+	UpdatedAt time.Time // Time when the pool was created or last replaced.
+}
+
+// LeaseService defines the operations available for managing the lease pools and their leases.
+type LeaseService interface {
+	// SavePool creates the pool or replaces the resources of the one with the same name.
+	// Leases held on resources removed from the pool stay valid until they are released.
+	SavePool(pool LeasePool) (LeasePoolDetails, error)
+
+	// GetPool retrieves the pool with the specified name.
+	GetPool(name string) (LeasePoolDetails, error)
+
+	// GetPools retrieves a list of all the pools.
+	GetPools() []LeasePoolDetails
+
+	// DeletePool removes the pool with the specified name along with its leases.
+	DeletePool(name string) error
+
+	// Acquire leases a free resource of the pool to a user of the node process, waiting until one is released.
+	Acquire(ctx context.Context, node, instance, pool string) (Lease, error)
+
+	// Release returns the leased resource to its pool.
+	Release(lease Lease) error
+
+	// ReleaseNode returns all the resources leased to the users of the node to their pools.
+	ReleaseNode(node string)
+
+	// ReleaseStale returns the resources leased to the users of the previous processes of the node to their pools.
+	ReleaseStale(node, instance string)
+}
+
+// Leaser acquires and releases the resources of the lease pools on behalf of the users of a node.
+type Leaser interface {
+	// Acquire leases a free resource of the pool, waiting until one is released or the context is done.
+	//
+	// Parameters:
+	//   - ctx: Context bounding the wait
+	//   - pool: Name of the pool
+	//
+	// Returns:
+	//   - Lease: The granted lease
+	//   - error: ErrLeasePoolNotFound if the pool doesn't exist, the context error if it is done first
+	Acquire(ctx context.Context, pool string) (Lease, error)
+
+	// Release returns the leased resource to its pool.
+	//
+	// Parameters:
+	//   - ctx: Context of the release
+	//   - lease: The lease to release
+	//
+	// Returns:
+	//   - error: ErrLeaseNotFound if the lease was already released
+	Release(ctx context.Context, lease Lease) error
+}
@@ -0,0 +1,53 @@
+package core
+
+import "time"
+
+// StartSchedule represents the configuration for scheduling an attack, either once at a specific moment
+// or repeatedly on a cron expression.
+type StartSchedule struct {
+	Name    string      // Name of the schedule.
+	StartAt *time.Time  // Moment of a one-shot run. Exactly one of StartAt and Cron is set.
+	Cron    *string     // Five-field cron expression of recurring runs, evaluated in UTC.
+	Attack  StartAttack // Configuration of the attack started on every run.
+}
+
+// ScheduleDetails contains all the details about a schedule, including its configuration and past runs.
+type ScheduleDetails struct {
+	ID        int64         // Unique ID of the schedule.
+	Name      string        // Name of the schedule.
+	StartAt   *time.Time    // Moment of a one-shot run.
+	Cron      *string       // Cron expression of recurring runs.
+	Attack    StartAttack   // Configuration of the attack started on every run.
+	CreatedAt time.Time     // Time when the schedule was created.
+	NextRunAt *time.Time    // Time of the next run. If nil, the schedule has no more runs.
+	Runs      []ScheduleRun // List of the most recent runs of the schedule.
+}
+
+// ScheduleRun describes a single run of a schedule.
+type ScheduleRun struct {
+	Run       int64     // Sequence number of the run within the schedule.
+	StartedAt time.Time // Time when the run was started.
+	AttackID  *int64    // ID of the attack created by the run. If nil, the attack failed to start.
+	Error     *string   // Reason the attack failed to start.
+}
+
+// ScheduledRun identifies the schedule run an attack was created by.
+type ScheduledRun struct {
+	ScheduleID int64 // ID of the schedule.
+	Run        int64 // Sequence number of the run within the schedule.
+}
+
+// ScheduleService defines the operations available for managing scheduled attacks.
+type ScheduleService interface {
+	// CreateSchedule creates a new schedule based on the provided configuration.
+	CreateSchedule(start StartSchedule) (ScheduleDetails, error)
+
+	// UpdateSchedule replaces the configuration of the schedule with the specified ID.
+	UpdateSchedule(scheduleID int64, start StartSchedule) (ScheduleDetails, error)
+
+	// CancelSchedule cancels the schedule with the specified ID.
+	CancelSchedule(scheduleID int64) error
+
+	// GetSchedules retrieves a list of all the schedules.
+	GetSchedules() []ScheduleDetails
+}
@@ -0,0 +1,33 @@
+package core
+
+import "context"
+
+// SetupCoordinator runs the attack-level setup of the scenarios once per attack across the nodes.
+// The first node claiming the setup of a scenario runs it and later its teardown, the other nodes
+// wait for its outcome before their users start their iterations.
+type SetupCoordinator interface {
+	// Claim asks to run the attack-level setup of a scenario, waiting while another node runs it.
+	//
+	// Parameters:
+	//   - ctx: Context bounding the wait
+	//   - attackID: ID of the attack
+	//   - scenario: Name of the scenario
+	//
+	// Returns:
+	//   - bool: true if the node must run the setup, false if another node has run it successfully
+	//   - error: ErrSetupFailed if the setup has failed on another node, ErrAttackNotFound if the attack has ended,
+	//     the context error if it is done first
+	Claim(ctx context.Context, attackID int64, scenario string) (bool, error)
+
+	// Complete reports the outcome of a claimed setup, releasing the nodes waiting for it.
+	//
+	// Parameters:
+	//   - ctx: Context of the report
+	//   - attackID: ID of the attack
+	//   - scenario: Name of the scenario
+	//   - failure: The setup error, nil if the setup succeeded
+	//
+	// Returns:
+	//   - error: ErrAttackNotFound if the attack has ended
+	Complete(ctx context.Context, attackID int64, scenario string, failure error) error
+}
@@ -0,0 +1,68 @@
+package core
+
+import "time"
+
+// StartSuite represents the configuration of a suite, an ordered chain of attacks run one after another.
+type StartSuite struct {
+	Name  string      // Name of the suite.
+	Steps []SuiteStep // Ordered list of steps to run.
+}
+
+// SuiteStep represents a single attack of a suite.
+type SuiteStep struct {
+	Name     string      // Name of the step.
+	DelaySec int64       // Time to wait after the previous attack ended before starting the step (in seconds).
+	Gate     *SuiteGate  // Conditions the previous attack must meet for the step to start. If nil, the step always starts.
+	Attack   StartAttack // Configuration of the attack run by the step.
+}
+
+// SuiteGate defines the conditions on the outcome of the previous attack of a suite.
+// Unset conditions are not checked.
+type SuiteGate struct {
+	MaxErrorRate *float64 // The highest acceptable share of failed requests, from 0 to 1.
+	MaxP95Ms     *float64 // The highest acceptable p95 latency (in milliseconds).
+	MinRequests  *int64   // The lowest acceptable number of processed requests.
+}
+
+// SuiteStatus defines the state of a suite.
+type SuiteStatus string
+
+const (
+	SuiteRunning   SuiteStatus = "running"   // A step of the suite is running or waiting to start.
+	SuitePassed    SuiteStatus = "passed"    // All steps of the suite have run.
+	SuiteFailed    SuiteStatus = "failed"    // A gate was not met or an attack failed to start.
+	SuiteCancelled SuiteStatus = "cancelled" // The suite was cancelled.
+)
+
+// SuiteStepResult describes the progress of a single step of a suite.
+type SuiteStepResult struct {
+	Step      int            // Index of the step within the suite.
+	AttackID  *int64         // ID of the attack started by the step. If nil, the attack was not started.
+	StartedAt *time.Time     // Time when the attack of the step was started.
+	Summary   *AttackSummary // Outcome of the attack once it has ended.
+	Error     *string        // Reason the step failed, e.g. an unmet gate.
+}
+
+// SuiteDetails contains all the details about a suite, including its configuration and progress.
+type SuiteDetails struct {
+	ID         int64             // Unique ID of the suite.
+	Name       string            // Name of the suite.
+	Steps      []SuiteStep       // Ordered list of steps.
+	Status     SuiteStatus       // Current state of the suite.
+	ActiveStep *int              // Index of the step currently running or waiting to start.
+	Results    []SuiteStepResult // Progress of the steps reached so far.
+	CreatedAt  time.Time         // Time when the suite was created.
+	EndedAt    *time.Time        // Time when the suite ended. If nil, the suite is still running.
+}
+
+// SuiteService defines the operations available for managing attack suites.
+type SuiteService interface {
+	// StartSuite starts a new suite based on the provided configuration.
+	StartSuite(start StartSuite) (SuiteDetails, error)
+
+	// CancelSuite cancels the suite with the specified ID, stopping its running attack.
+	CancelSuite(suiteID int64) error
+
+	// GetSuites retrieves a list of all the suites.
+	GetSuites() []SuiteDetails
+}
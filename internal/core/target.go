@@ -0,0 +1,31 @@
+package core
+
+import "time"
+
+// Target describes an environment the attacks are run against, such as staging or a feature environment.
+type Target struct {
+	Name     string            // Name of the target.
+	Services map[string]string // Base URLs of the target services, indexed by service name.
+	Headers  map[string]string // Headers sent with every request of the attacks run against the target.
+}
+
+// TargetDetails contains a target along with the time of its last change.
+type TargetDetails struct {
+	Target              // The target configuration.
+	UpdatedAt time.Time // Time when the target was created or last replaced.
+}
+
+// TargetService defines the operations available for managing the attack targets.
+type TargetService interface {
+	// SaveTarget creates the target or replaces the one with the same name.
+	SaveTarget(target Target) (TargetDetails, error)
+
+	// GetTarget retrieves the target with the specified name.
+	GetTarget(name string) (TargetDetails, error)
+
+	// GetTargets retrieves a list of all the targets.
+	GetTargets() []TargetDetails
+
+	// DeleteTarget removes the target with the specified name. Running attacks keep their copy of it.
+	DeleteTarget(name string) error
+}
@@ -0,0 +1,69 @@
+package core
+
+import "time"
+
+// TemplateParameter describes a placeholder of an attack template.
+type TemplateParameter struct {
+	Name        string // Name of the parameter, referenced in the template as "${name}".
+	Description string // Description of the parameter.
+	Default     any    // Value used when the parameter is not given on run. If nil, the parameter is required.
+}
+
+// StartTemplate represents the configuration of a new attack template or of a new version of an existing one.
+type StartTemplate struct {
+	Name        string              // Name of the template.
+	Description string              // Description of the template.
+	Parameters  []TemplateParameter // Parameters referenced by the placeholders of the attack.
+	Attack      map[string]any      // Attack configuration in the form of the REST request body, holding placeholders.
+}
+
+// TemplateDetails contains all the details about a single version of an attack template.
+type TemplateDetails struct {
+	Name        string              // Name of the template.
+	Version     int64               // Version of the template, starting from 1.
+	Description string              // Description of the template.
+	Parameters  []TemplateParameter // Parameters referenced by the placeholders of the attack.
+	Attack      map[string]any      // Attack configuration holding placeholders.
+	CreatedAt   time.Time           // Time when the version was created.
+}
+
+// RunTemplate represents a request to render an attack template into an attack configuration.
+type RunTemplate struct {
+	Name       string         // Name of the template.
+	Version    *int64         // Version of the template. If nil, the latest version is used.
+	Parameters map[string]any // Values of the parameters, overriding their defaults.
+	Overrides  map[string]any // JSON merge patch applied to the rendered attack configuration.
+}
+
+// TemplateRef identifies the template version an attack was launched from.
+type TemplateRef struct {
+	Name    string // Name of the template.
+	Version int64  // Version of the template.
+}
+
+// TemplateService defines the operations available for managing attack templates.
+type TemplateService interface {
+	// CreateTemplate creates the first version of a new template.
+	CreateTemplate(start StartTemplate) (TemplateDetails, error)
+
+	// UpdateTemplate adds a new version to the template with the specified name.
+	UpdateTemplate(name string, start StartTemplate) (TemplateDetails, error)
+
+	// ImportTemplate creates a template or adds a new version to it if it already exists.
+	ImportTemplate(start StartTemplate) (TemplateDetails, error)
+
+	// DeleteTemplate deletes all versions of the template with the specified name.
+	DeleteTemplate(name string) error
+
+	// GetTemplates retrieves the latest versions of all the templates.
+	GetTemplates() []TemplateDetails
+
+	// GetTemplate retrieves a version of the template, the latest one if version is nil.
+	GetTemplate(name string, version *int64) (TemplateDetails, error)
+
+	// GetTemplateVersions retrieves all versions of the template with the specified name.
+	GetTemplateVersions(name string) ([]TemplateDetails, error)
+
+	// RenderTemplate substitutes the parameters of a template version and applies the overrides.
+	RenderTemplate(run RunTemplate) (map[string]any, TemplateRef, error)
+}
@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"context"
+	"load-generation-system/internal/core"
+	"sort"
+	"sync"
+)
+
+// contextKey defines a custom key type for storing values in the context.
+type contextKey string
+
+// attackID is the key used to store and retrieve the ID of the attack a request belongs to.
+const attackID contextKey = "attackID"
+
+// WithAttack returns a copy of the context marking the requests made within it as part of the attack.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - id: ID of the attack
+//
+// Returns:
+//   - context.Context: The context carrying the attack ID
+func WithAttack(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, attackID, id)
+}
+
+// AttackFromContext extracts the ID of the attack a request belongs to.
+//
+// Parameters:
+//   - ctx: The context of the request
+//
+// Returns:
+//   - int64: ID of the attack
+//   - bool: false if the request is not part of any attack
+func AttackFromContext(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(attackID).(int64)
+	return id, ok
+}
+
+// AttackStatsCollector accumulates request statistics per attack until they are flushed.
+// Unlike the Prometheus metrics, the statistics are sent to the manager to drive the adaptive attacks.
+type AttackStatsCollector struct {
+	stats map[int64]*core.AttackStats // Statistics accumulated since the last flush
+	mu    sync.Mutex                  // Mutex to protect the statistics
+}
+
+// AttackStats is the collector of the request statistics of the attacks running on the node.
+var AttackStats = &AttackStatsCollector{
+	stats: make(map[int64]*core.AttackStats),
+}
+
+// Observe records a processed request of an attack.
+//
+// Parameters:
+//   - id: ID of the attack
+//   - duration: Request duration in seconds
+//   - failed: Whether the request failed
+func (c *AttackStatsCollector) Observe(id int64, duration float64, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.entry(id)
+
+	stats.Requests++
+	if failed {
+		stats.Errors++
+	}
+	stats.Buckets[sort.SearchFloat64s(RequestDurationBuckets, duration)]++
+}
+
+// ObserveCheck records the result of a check run on a response of an attack.
+//
+// Parameters:
+//   - id: ID of the attack
+//   - check: Name of the check
+//   - passed: Whether the check passed
+func (c *AttackStatsCollector) ObserveCheck(id int64, check string, passed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.entry(id)
+	if stats.Checks == nil {
+		stats.Checks = make(map[string]core.CheckStats)
+	}
+
+	checkStats := stats.Checks[check]
+	if passed {
+		checkStats.Passed++
+	} else {
+		checkStats.Failed++
+	}
+	stats.Checks[check] = checkStats
+}
+
+// ObserveSetupFailure records a failed setup of a user or of an attack.
+//
+// Parameters:
+//   - id: ID of the attack
+func (c *AttackStatsCollector) ObserveSetupFailure(id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entry(id).SetupFailures++
+}
+
+// entry returns the statistics of an attack accumulated since the previous flush, creating them if needed.
+//
+// Parameters:
+//   - id: ID of the attack
+//
+// Returns:
+//   - *core.AttackStats: Statistics of the attack
+//
+// Must be called with c.mu held.
+func (c *AttackStatsCollector) entry(id int64) *core.AttackStats {
+	stats, exists := c.stats[id]
+	if !exists {
+		stats = &core.AttackStats{
+			AttackID: id,
+			Bounds:   RequestDurationBuckets,
+			Buckets:  make([]int64, len(RequestDurationBuckets)+1),
+		}
+		c.stats[id] = stats
+	}
+
+	return stats
+}
+
+// Flush returns the statistics accumulated since the previous flush and resets them.
+//
+// Returns:
+//   - []core.AttackStats: Statistics of every attack that processed requests
+func (c *AttackStatsCollector) Flush() []core.AttackStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	flushed := make([]core.AttackStats, 0, len(c.stats))
+	for _, stats := range c.stats {
+		flushed = append(flushed, *stats)
+	}
+	c.stats = make(map[int64]*core.AttackStats)
+
+	return flushed
+}
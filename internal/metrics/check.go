@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Results of the checks, used as the "result" label of the checks counter.
+const (
+	checkPassed = "pass"
+	checkFailed = "fail"
+)
+
+var (
+	// ChecksCounter is a counter metric to track the results of the checks run on the responses.
+	// It is labeled with "check" (the name of the check) and "result" (pass or fail), so the failures
+	// of every check can be followed on their own, whether they fail the iteration or are only recorded.
+	ChecksCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "load_generation_system_checks_count", // Metric name
+		},
+		[]string{"check", "result"}, // Labels
+	)
+)
+
+// ObserveCheck records the result of a check, in the metrics and in the statistics of the attack
+// the request belongs to. The checks of the requests made by the lifecycle hooks are not recorded.
+//
+// Parameters:
+//   - ctx: The context of the request
+//   - check: Name of the check
+//   - passed: Whether the check passed
+func ObserveCheck(ctx context.Context, check string, passed bool) {
+	if _, ok := HookFromContext(ctx); ok {
+		return
+	}
+
+	result := checkPassed
+	if !passed {
+		result = checkFailed
+	}
+	ChecksCounter.WithLabelValues(check, result).Inc()
+
+	if attackID, ok := AttackFromContext(ctx); ok {
+		AttackStats.ObserveCheck(attackID, check, passed)
+	}
+}
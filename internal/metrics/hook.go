@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Lifecycle hooks of the scenarios, used as the "hook" label of the hook metrics.
+const (
+	HookSetup          = "setup"           // Setup of a user, run before its first iteration.
+	HookTeardown       = "teardown"        // Teardown of a user, run once it is destroyed.
+	HookAttackSetup    = "attack_setup"    // Setup of an attack, run once per attack before the users start.
+	HookAttackTeardown = "attack_teardown" // Teardown of an attack, run once the attack is over.
+)
+
+// hook is the key used to store and retrieve the lifecycle hook a request is made by.
+const hook contextKey = "hook"
+
+var (
+	// HookDurationSecondsHist is a histogram metric that tracks the duration of the lifecycle hooks of the scenarios in seconds.
+	// It is labeled with "scenario" (the name of the scenario) and "hook" (setup, teardown, attack_setup or attack_teardown).
+	// The requests made by the hooks are not part of the request metrics, so this is where their time is accounted.
+	HookDurationSecondsHist = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "load_generation_system_hook_duration_seconds", // Metric name
+			Buckets: RequestDurationBuckets,                         // Same bucket ranges as the request durations.
+		},
+		[]string{"scenario", "hook"}, // Labels
+	)
+
+	// HookFailuresCounter is a counter metric to track the failed lifecycle hooks of the scenarios.
+	// It is labeled with "scenario" (the name of the scenario) and "hook" (setup, teardown, attack_setup or attack_teardown),
+	// so the setup failures are followed apart from the failures of the iterations.
+	HookFailuresCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "load_generation_system_hook_failures_count", // Metric name
+		},
+		[]string{"scenario", "hook"}, // Labels
+	)
+)
+
+// WithHook returns a copy of the context marking the requests made within it as part of a lifecycle hook.
+// Such requests are kept out of the request metrics and of the attack statistics.
+//
+// Parameters:
+//   - ctx: The parent context
+//   - name: The lifecycle hook, one of the Hook constants
+//
+// Returns:
+//   - context.Context: The context carrying the hook
+func WithHook(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, hook, name)
+}
+
+// HookFromContext extracts the lifecycle hook a request is made by.
+//
+// Parameters:
+//   - ctx: The context of the request
+//
+// Returns:
+//   - string: The lifecycle hook
+//   - bool: false if the request is made by an iteration
+func HookFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(hook).(string)
+	return name, ok
+}
+
+// ObserveHook records a run of a lifecycle hook. A failed setup is also counted in the statistics of the attack
+// the hook is run for.
+//
+// Parameters:
+//   - ctx: The context of the hook
+//   - scenario: Name of the scenario
+//   - name: The lifecycle hook, one of the Hook constants
+//   - duration: Duration of the hook
+//   - failed: Whether the hook failed
+func ObserveHook(ctx context.Context, scenario, name string, duration time.Duration, failed bool) {
+	HookDurationSecondsHist.WithLabelValues(scenario, name).Observe(duration.Seconds())
+	if !failed {
+		return
+	}
+
+	HookFailuresCounter.WithLabelValues(scenario, name).Inc()
+	if attackID, ok := AttackFromContext(ctx); ok && (name == HookSetup || name == HookAttackSetup) {
+		AttackStats.ObserveSetupFailure(attackID)
+	}
+}
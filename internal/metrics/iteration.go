@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// DroppedIterationsCounter is a counter metric to track the number of iterations that were not started.
+	// It increments every time an arrival-rate increment has to start an iteration, but its users pool is exhausted
+	// and cannot grow anymore. It is labeled with "scenario" (the name of the scenario of the dropped iteration).
+	DroppedIterationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "load_generation_system_dropped_iterations_count", // Metric name
+		},
+		[]string{"scenario"}, // Labels
+	)
+)
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// LeasePoolSizeGauge is a gauge metric that tracks the number of resources of each lease pool.
+	// It is labeled with "pool" (the name of the lease pool).
+	LeasePoolSizeGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "load_generation_system_lease_pool_size", // Metric name
+		},
+		[]string{"pool"}, // Labels
+	)
+
+	// LeasedResourcesGauge is a gauge metric that tracks the number of resources of each lease pool
+	// currently leased to the users of the nodes. It is labeled with "pool" (the name of the lease pool).
+	LeasedResourcesGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "load_generation_system_lease_pool_leased", // Metric name
+		},
+		[]string{"pool"}, // Labels
+	)
+
+	// LeasePoolUtilizationGauge is a gauge metric that tracks the share of the resources of each lease pool
+	// currently leased, between 0 and 1. It is labeled with "pool" (the name of the lease pool).
+	LeasePoolUtilizationGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "load_generation_system_lease_pool_utilization", // Metric name
+		},
+		[]string{"pool"}, // Labels
+	)
+
+	// LeaseWaitingGauge is a gauge metric that tracks the number of users waiting for a resource of each lease pool.
+	// It is labeled with "pool" (the name of the lease pool).
+	LeaseWaitingGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "load_generation_system_lease_pool_waiting", // Metric name
+		},
+		[]string{"pool"}, // Labels
+	)
+
+	// LeaseWaitHistogram is a histogram metric that tracks the time the users wait for a resource of each lease pool,
+	// in seconds. Only the granted leases are observed. It is labeled with "pool" (the name of the lease pool).
+	LeaseWaitHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "load_generation_system_lease_wait_seconds", // Metric name
+			Buckets: []float64{0.001, 0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+		},
+		[]string{"pool"}, // Labels
+	)
+)
@@ -5,6 +5,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+// RequestDurationBuckets are the upper bounds of the request duration buckets in seconds.
+// They are shared by the Prometheus histogram and the per-attack statistics.
+var RequestDurationBuckets = []float64{
+	0.005, 0.01, 0.025, 0.05, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9,
+	1.0, 1.1, 1.2, 1.3, 1.4, 1.5, 2.0, 2.5, 3.0, 3.5, 4.0, 4.5, 5.0, 10.0,
+}
+
 var (
 	// TotalRequestsCounter is a counter metric to track the total number of requests.
 	// It increments every time a request is received. It is labeled with "path" (the target server path) and "method".
@@ -30,11 +37,8 @@ var (
 	// It provides insight into how long requests take to complete, with predefined bucket ranges for different duration intervals.
 	RequestDurationSecondsHist = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
-			Name: "load_generation_system_request_duration_seconds", // Metric name
-			Buckets: []float64{ // Predefined bucket ranges for request durations in seconds.
-				0.005, 0.01, 0.025, 0.05, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9,
-				1.0, 1.1, 1.2, 1.3, 1.4, 1.5, 2.0, 2.5, 3.0, 3.5, 4.0, 4.5, 5.0, 10.0,
-			},
+			Name:    "load_generation_system_request_duration_seconds", // Metric name
+			Buckets: RequestDurationBuckets,                            // Predefined bucket ranges for request durations in seconds.
 		},
 		[]string{"path", "method", "status"}, // Labels
 	)
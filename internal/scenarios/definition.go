@@ -0,0 +1,670 @@
+package scenarios
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"load-generation-system/internal/core"
+	"load-generation-system/internal/service/callers"
+	httpService "load-generation-system/internal/service/http"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// placeholder matches a template variable reference such as {{order_id}}.
+	placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+	// variableName matches a valid template variable name.
+	variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// targetPrefix starts the names of the variables holding the base URLs of the services of the attack target,
+// such as {{target_test}} for the Test service.
+const targetPrefix = "target_"
+
+// leasePrefix starts the names of the variables holding the resources leased by the user, such as {{lease_accounts}}.
+const leasePrefix = "lease_"
+
+// Definition is a declarative HTTP scenario, described in JSON instead of being written in Go.
+// Its steps run in order on every iteration of a user, through the HTTP client of the user caller,
+// so their requests are measured the same way as the ones of the Go scenarios.
+// The setup and teardown steps run through the same client, but their requests are left out of the metrics.
+type Definition struct {
+	// Name is the name the scenario is advertised under.
+	Name string `json:"name"`
+
+	// Description provides a textual explanation of the scenario's purpose.
+	Description string `json:"description"`
+
+	// Variables holds the initial values of the template variables of every iteration.
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// Parameters are the parameters the attacks pass to the scenario, available to the steps as template variables.
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Feeds are the datasets a row is read from at the start of every iteration,
+	// its values available to the steps as {{<dataset>_<column>}} template variables.
+	Feeds []string `json:"feeds,omitempty"`
+
+	// Leases are the lease pools the user holds a resource of for its whole session, acquired on its first iteration
+	// and available to the steps as {{lease_<pool>}} template variables.
+	Leases []string `json:"leases,omitempty"`
+
+	// Steps are the HTTP requests of an iteration, in their execution order.
+	Steps []Step `json:"steps"`
+
+	// Setup are the HTTP requests run once for every user before its first iteration, such as a login.
+	// The variables they extract are kept for the iterations and the teardown of the user.
+	Setup []Step `json:"setup,omitempty"`
+
+	// Teardown are the HTTP requests run once for every user when it is destroyed, such as a logout.
+	Teardown []Step `json:"teardown,omitempty"`
+
+	// AttackSetup are the HTTP requests run once per attack before the users start, such as seeding fixtures.
+	// The variables they extract are only available to the attack teardown.
+	AttackSetup []Step `json:"attack_setup,omitempty"`
+
+	// AttackTeardown are the HTTP requests run once the attack is over, such as deleting the fixtures.
+	AttackTeardown []Step `json:"attack_teardown,omitempty"`
+}
+
+// Parameter describes a parameter of a declarative scenario.
+type Parameter struct {
+	// Name is the name of the parameter and of the template variable holding its value.
+	Name string `json:"name"`
+
+	// Type is the type of the parameter value: string, int, float or bool.
+	Type string `json:"type"`
+
+	// Description provides a textual explanation of the parameter.
+	Description string `json:"description,omitempty"`
+
+	// Required tells whether the attacks must set the parameter.
+	Required bool `json:"required,omitempty"`
+
+	// Default is the value of the parameter when an attack does not set it.
+	Default any `json:"default,omitempty"`
+}
+
+// Step is a single HTTP request of a declarative scenario.
+// The URL, the header values and the body may reference the template variables as {{name}},
+// and the base URLs of the services of the attack target as {{target_<service>}}.
+type Step struct {
+	// Name identifies the step in the errors of the scenario.
+	Name string `json:"name"`
+
+	// Method is the HTTP method of the request: GET, POST, PUT, PATCH or DELETE.
+	Method string `json:"method"`
+
+	// URL is the template of the request URL. Requests are reported in the metrics under the template,
+	// so the variable parts of the URL do not multiply the metric series.
+	URL string `json:"url"`
+
+	// Headers are the templates of the request headers.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is the template of the JSON body of the request.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// Extract maps variable names to the dot separated paths of the values they take from the JSON response body.
+	Extract map[string]string `json:"extract,omitempty"`
+
+	// Extractors take variables out of the response with a JSONPath expression, a regular expression,
+	// or from a header or a cookie.
+	Extractors []Extractor `json:"extractors,omitempty"`
+
+	// Checks are the assertions on the response. Their results are counted per check name,
+	// and a failed check fails the iteration unless it only records its result.
+	Checks []Check `json:"checks,omitempty"`
+
+	// ThinkTimeSec is the pause after the step (in seconds).
+	ThinkTimeSec float64 `json:"think_time_sec,omitempty"`
+}
+
+// Check is an assertion on the response of a step. All the assertions it sets must hold for it to pass.
+// When a step has no check on the status code, the statuses from 200 to 399 are accepted.
+type Check struct {
+	// Name identifies the check in the errors of the scenario and in the metrics.
+	Name string `json:"name"`
+
+	// Mode is what a failure of the check does: fail (the default) fails the iteration, record only counts it.
+	Mode string `json:"mode,omitempty"`
+
+	// Status lists the accepted status codes of the response.
+	Status []int `json:"status,omitempty"`
+
+	// BodyContains is the text the response body must contain.
+	BodyContains *string `json:"body_contains,omitempty"`
+
+	// Path is the dot separated path of a value that must exist in the JSON response body.
+	Path string `json:"path,omitempty"`
+
+	// Equals is the expected value found at Path.
+	Equals *string `json:"equals,omitempty"`
+
+	// JSONSchema is the JSON schema the response body must conform to.
+	JSONSchema json.RawMessage `json:"json_schema,omitempty"`
+
+	// MaxLatencyMs is the latency budget of the request (in milliseconds).
+	MaxLatencyMs float64 `json:"max_latency_ms,omitempty"`
+}
+
+// Extractor takes a variable out of the response of a step.
+type Extractor struct {
+	// Name is the name of the variable.
+	Name string `json:"name"`
+
+	// Kind is where the value is taken from: jsonpath, regex, header or cookie.
+	Kind string `json:"kind"`
+
+	// Expression is the JSONPath expression, the regular expression, the header name or the cookie name.
+	Expression string `json:"expression"`
+}
+
+// ParseDefinition reads and validates a declarative scenario from its JSON representation.
+//
+// Parameters:
+//   - data: JSON content of the definition
+//
+// Returns:
+//   - Definition: The parsed definition
+//   - error: core.ErrBadScenario if the definition is malformed or invalid
+func ParseDefinition(data []byte) (Definition, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var definition Definition
+	if err := decoder.Decode(&definition); err != nil {
+		return Definition{}, fmt.Errorf("%w: %v", core.ErrBadScenario, err)
+	}
+	if err := definition.Validate(); err != nil {
+		return Definition{}, err
+	}
+
+	return definition, nil
+}
+
+// Validate checks that the definition can be run. Every referenced variable must either have
+// an initial value, be extracted by one of the previous steps, come from the attack target, a fed dataset or a lease.
+// The variables extracted by the user setup are available to the iterations and the user teardown,
+// the ones extracted by the attack setup only to the attack teardown. The teardowns read no fed dataset.
+//
+// Returns:
+//   - error: core.ErrBadScenario describing the first problem found
+func (d Definition) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("%w: name is required", core.ErrBadScenario)
+	}
+	if len(d.Steps) == 0 {
+		return fmt.Errorf("%w: scenario %s has no steps", core.ErrBadScenario, d.Name)
+	}
+
+	defined := make(map[string]bool, len(d.Variables)+len(d.Parameters))
+	for name := range d.Variables {
+		if !variableName.MatchString(name) {
+			return fmt.Errorf("%w: bad variable name %q", core.ErrBadScenario, name)
+		}
+		defined[name] = true
+	}
+	for _, parameter := range d.ParameterSchema() {
+		if !variableName.MatchString(parameter.Name) || strings.HasPrefix(parameter.Name, targetPrefix) {
+			return fmt.Errorf("%w: bad parameter name %q", core.ErrBadScenario, parameter.Name)
+		}
+		if defined[parameter.Name] {
+			return fmt.Errorf("%w: parameter %s is already defined", core.ErrBadScenario, parameter.Name)
+		}
+		switch parameter.Type {
+		case core.ParameterString, core.ParameterInt, core.ParameterFloat, core.ParameterBool:
+		default:
+			return fmt.Errorf("%w: parameter %s has unknown type %q", core.ErrBadScenario, parameter.Name, parameter.Type)
+		}
+		if parameter.Default != nil {
+			if _, err := ConvertParam(parameter, parameter.Default); err != nil {
+				return fmt.Errorf("%w: %v", core.ErrBadScenario, err)
+			}
+		}
+		defined[parameter.Name] = true
+	}
+	for i, dataset := range d.Feeds {
+		if !variableName.MatchString(dataset) || slices.Contains(d.Feeds[:i], dataset) {
+			return fmt.Errorf("%w: bad feed dataset %q", core.ErrBadScenario, dataset)
+		}
+	}
+	for i, pool := range d.Leases {
+		if !variableName.MatchString(pool) || slices.Contains(d.Leases[:i], pool) {
+			return fmt.Errorf("%w: bad lease pool %q", core.ErrBadScenario, pool)
+		}
+		defined[leasePrefix+pool] = true
+	}
+	fed := func(name string) bool {
+		return slices.ContainsFunc(d.Feeds, func(dataset string) bool {
+			return strings.HasPrefix(name, dataset+"_")
+		})
+	}
+
+	// The variables of the user setup are kept for its whole session, the ones of an iteration only for the iteration,
+	// and the ones of the attack setup only for the attack teardown. The teardowns read no fed dataset.
+	unfed := func(string) bool {
+		return false
+	}
+	attackDefined := maps.Clone(defined)
+	if err := validateSteps("setup", d.Setup, defined, fed); err != nil {
+		return err
+	}
+	if err := validateSteps("", d.Steps, maps.Clone(defined), fed); err != nil {
+		return err
+	}
+	if err := validateSteps("teardown", d.Teardown, defined, unfed); err != nil {
+		return err
+	}
+	if err := validateSteps("attack setup", d.AttackSetup, attackDefined, fed); err != nil {
+		return err
+	}
+	if err := validateSteps("attack teardown", d.AttackTeardown, attackDefined, unfed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSteps checks that the steps of a section of the definition can be run.
+// The variables extracted by the steps are added to the defined ones.
+//
+// Parameters:
+//   - section: Name of the section in the errors, empty for the steps of the iterations
+//   - steps: The steps of the section
+//   - defined: Variables defined before the steps
+//   - fed: Tells whether a variable comes from a fed dataset
+//
+// Returns:
+//   - error: core.ErrBadScenario describing the first problem found
+func validateSteps(section string, steps []Step, defined map[string]bool, fed func(name string) bool) error {
+	for i, step := range steps {
+		stepName := step.Name
+		if stepName == "" {
+			stepName = strconv.Itoa(i)
+		}
+		bad := func(format string, a ...any) error {
+			return fmt.Errorf("%w: %s %s: %s", core.ErrBadScenario, strings.TrimSpace(section+" step"), stepName, fmt.Sprintf(format, a...))
+		}
+
+		switch step.Method {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return bad("unsupported method %q", step.Method)
+		}
+		if step.URL == "" {
+			return bad("url is required")
+		}
+		if len(step.Body) != 0 && !json.Valid(placeholder.ReplaceAll(step.Body, []byte("0"))) {
+			return bad("body is not a JSON template")
+		}
+		if step.ThinkTimeSec < 0 {
+			return bad("think time must not be negative")
+		}
+
+		templates := []string{step.URL, string(step.Body)}
+		for _, value := range step.Headers {
+			templates = append(templates, value)
+		}
+		for _, template := range templates {
+			for _, match := range placeholder.FindAllStringSubmatch(template, -1) {
+				if !defined[match[1]] && !strings.HasPrefix(match[1], targetPrefix) && !fed(match[1]) {
+					return bad("variable %s is not defined", match[1])
+				}
+			}
+		}
+
+		for i, check := range step.checks() {
+			if len(check.Statuses) == 0 && step.Checks[i].BodyContains == nil && check.JSONPath == "" &&
+				len(check.JSONSchema) == 0 && check.MaxLatency == 0 {
+				return bad("check %s asserts nothing", check.Name)
+			}
+			if err := httpService.ValidateCheck(check); err != nil {
+				return bad("%v", err)
+			}
+		}
+
+		for name, path := range step.Extract {
+			if !variableName.MatchString(name) {
+				return bad("bad variable name %q", name)
+			}
+			if path == "" {
+				return bad("variable %s is extracted from an empty path", name)
+			}
+			defined[name] = true
+		}
+
+		for _, extractor := range step.extractors() {
+			if !variableName.MatchString(extractor.Name) {
+				return bad("bad variable name %q", extractor.Name)
+			}
+			if err := httpService.ValidateExtractor(extractor); err != nil {
+				return bad("%v", err)
+			}
+			defined[extractor.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// ParameterSchema returns the parameters of the definition in the form the scenarios advertise them.
+//
+// Returns:
+//   - []core.ScenarioParameter: Schema of the parameters of the definition
+func (d Definition) ParameterSchema() []core.ScenarioParameter {
+	if len(d.Parameters) == 0 {
+		return nil
+	}
+
+	parameters := make([]core.ScenarioParameter, 0, len(d.Parameters))
+	for _, parameter := range d.Parameters {
+		parameters = append(parameters, core.ScenarioParameter{
+			Name:        parameter.Name,
+			Type:        core.ParameterType(parameter.Type),
+			Description: parameter.Description,
+			Required:    parameter.Required,
+			Default:     parameter.Default,
+		})
+	}
+
+	return parameters
+}
+
+// Scenario builds the runnable scenario of the definition.
+//
+// Returns:
+//   - Scenario: Scenario running the steps of the definition on every iteration, along with its lifecycle hooks
+func (d Definition) Scenario() Scenario {
+	scenario := New(d.Name, d.Description, d.runner(d.Steps, true, false)).WithParameters(d.ParameterSchema()...)
+	if len(d.Setup) != 0 {
+		scenario = scenario.WithSetup(d.runner(d.Setup, true, true))
+	}
+	if len(d.Teardown) != 0 {
+		scenario = scenario.WithTeardown(d.runner(d.Teardown, false, false))
+	}
+	if len(d.AttackSetup) != 0 || len(d.AttackTeardown) != 0 {
+		scenario = scenario.WithAttackHooks(d.runner(d.AttackSetup, true, true), d.runner(d.AttackTeardown, false, false))
+	}
+
+	return scenario
+}
+
+// runner builds the function running steps of the definition through a caller.
+//
+// Parameters:
+//   - steps: The steps to run, in their execution order
+//   - feed: Whether a row of the fed datasets is read before the steps
+//   - keep: Whether the extracted variables are kept in the caller state for its next runs
+//
+// Returns:
+//   - func(ctx context.Context, caller *callers.Caller) error: Function running the steps, nil if there are none
+func (d Definition) runner(steps []Step, feed, keep bool) func(ctx context.Context, caller *callers.Caller) error {
+	if len(steps) == 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, caller *callers.Caller) error {
+		variables := maps.Clone(d.Variables)
+		if variables == nil {
+			variables = make(map[string]string)
+		}
+		for name, value := range caller.State.Params {
+			variables[name] = fmt.Sprint(value)
+		}
+		if caller.Target != nil {
+			for service, url := range caller.Target.Services {
+				variables[targetPrefix+service] = strings.TrimSuffix(url, "/")
+			}
+		}
+		if feed {
+			for _, dataset := range d.Feeds {
+				row, err := caller.Row(dataset)
+				if err != nil {
+					return err
+				}
+				for column, value := range row {
+					variables[dataset+"_"+column] = value
+				}
+			}
+		}
+		for _, pool := range d.Leases {
+			resource, err := caller.Lease(ctx, pool)
+			if err != nil {
+				return err
+			}
+			variables[leasePrefix+pool] = resource
+		}
+
+		for i, step := range steps {
+			if err := step.run(ctx, caller.HTTPClient, variables); err != nil {
+				if step.Name != "" {
+					return fmt.Errorf("step %s: %w", step.Name, err)
+				}
+				return fmt.Errorf("step %d: %w", i, err)
+			}
+
+			if step.ThinkTimeSec > 0 {
+				timer := time.NewTimer(time.Duration(step.ThinkTimeSec * float64(time.Second)))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+
+		if keep {
+			if caller.State.Params == nil {
+				caller.State.Params = make(map[string]any)
+			}
+			for _, step := range steps {
+				for name := range step.Extract {
+					caller.State.Params[name] = variables[name]
+				}
+				for _, extractor := range step.Extractors {
+					caller.State.Params[extractor.Name] = variables[extractor.Name]
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// run sends the request of the step, checks its response and extracts the variables from it.
+//
+// Parameters:
+//   - ctx: The context of the iteration
+//   - client: The HTTP client of the user
+//   - variables: Template variables of the iteration, updated with the extracted values
+//
+// Returns:
+//   - error: The request error, or core.ErrCheckFailed if the response does not pass a check
+func (s Step) run(ctx context.Context, client core.Client, variables map[string]string) error {
+	// The URL template with the variables replaced by verbs keeps the metric path stable
+	var args []any
+	format := placeholder.ReplaceAllStringFunc(strings.ReplaceAll(s.URL, "%", "%%"), func(match string) string {
+		args = append(args, variables[placeholder.FindStringSubmatch(match)[1]])
+		return "%s"
+	})
+
+	req := client.R().SetPath(format, args...).Check(s.checks()...)
+	for header, value := range s.Headers {
+		req = req.SetHeader(header, render(value, variables))
+	}
+	if len(s.Body) != 0 {
+		body := json.RawMessage(render(string(s.Body), variables))
+		if !json.Valid(body) {
+			return fmt.Errorf("%w: body is not valid JSON once rendered", core.ErrBadScenario)
+		}
+		req = req.SetBody(body)
+	}
+
+	var resp core.Response
+	var err error
+	switch s.Method {
+	case http.MethodPost:
+		resp, err = req.Post(ctx)
+	case http.MethodPut:
+		resp, err = req.Put(ctx)
+	case http.MethodPatch:
+		resp, err = req.Patch(ctx)
+	case http.MethodDelete:
+		resp, err = req.Delete(ctx)
+	default:
+		resp, err = req.Get(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	var document any
+	parsed := json.Unmarshal(resp.Body(), &document) == nil
+
+	for name, path := range s.Extract {
+		value, found := lookup(document, path)
+		if !parsed || !found {
+			return fmt.Errorf("%w: variable %s: %s not found", core.ErrCheckFailed, name, path)
+		}
+		variables[name] = value
+	}
+
+	extracted, err := resp.Extract(s.extractors()...)
+	if err != nil {
+		return err
+	}
+	maps.Copy(variables, extracted)
+
+	return nil
+}
+
+// extractors converts the extractors of the step.
+//
+// Returns:
+//   - []core.Extractor: The extractors run on the response of the step
+func (s Step) extractors() []core.Extractor {
+	extractors := make([]core.Extractor, 0, len(s.Extractors))
+	for _, extractor := range s.Extractors {
+		extractors = append(extractors, core.Extractor{
+			Name:       extractor.Name,
+			Kind:       core.ExtractorKind(extractor.Kind),
+			Expression: extractor.Expression,
+		})
+	}
+
+	return extractors
+}
+
+// checks converts the checks of the step.
+//
+// Returns:
+//   - []core.Check: The checks run on the response of the step
+func (s Step) checks() []core.Check {
+	checks := make([]core.Check, 0, len(s.Checks))
+	for _, check := range s.Checks {
+		converted := core.Check{
+			Name:       check.Name,
+			Mode:       core.CheckMode(check.Mode),
+			Statuses:   check.Status,
+			Equals:     check.Equals,
+			JSONSchema: check.JSONSchema,
+			MaxLatency: time.Duration(check.MaxLatencyMs * float64(time.Millisecond)),
+		}
+		if check.BodyContains != nil {
+			converted.BodyContains = *check.BodyContains
+		}
+		if check.Path != "" {
+			converted.JSONPath = jsonPath(check.Path)
+		}
+		checks = append(checks, converted)
+	}
+
+	return checks
+}
+
+// jsonPath converts a dot separated path into a JSONPath expression.
+//
+// Parameters:
+//   - path: Path of a value, such as data.items.0.id
+//
+// Returns:
+//   - string: The JSONPath expression, such as $['data']['items'][0]['id']
+func jsonPath(path string) string {
+	var expression strings.Builder
+	expression.WriteString("$")
+	for _, segment := range strings.Split(path, ".") {
+		if _, err := strconv.ParseUint(segment, 10, 0); err == nil {
+			expression.WriteString("[" + segment + "]")
+			continue
+		}
+		expression.WriteString("['" + segment + "']")
+	}
+
+	return expression.String()
+}
+
+// render replaces the variable references of a template with their values.
+//
+// Parameters:
+//   - template: The template to render
+//   - variables: Values of the variables
+//
+// Returns:
+//   - string: The rendered text
+func render(template string, variables map[string]string) string {
+	return placeholder.ReplaceAllStringFunc(template, func(match string) string {
+		return variables[placeholder.FindStringSubmatch(match)[1]]
+	})
+}
+
+// lookup finds a value in a decoded JSON document by its dot separated path.
+// Path segments select object keys or, when numeric, array items.
+//
+// Parameters:
+//   - document: The decoded JSON document
+//   - path: Path of the value, such as data.items.0.id
+//
+// Returns:
+//   - string: The found value, strings as they are and other values in their JSON form
+//   - bool: false if the path does not exist in the document
+func lookup(document any, path string) (string, bool) {
+	value := document
+	for _, segment := range strings.Split(path, ".") {
+		switch node := value.(type) {
+		case map[string]any:
+			item, exists := node[segment]
+			if !exists {
+				return "", false
+			}
+			value = item
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false
+			}
+			value = node[index]
+		default:
+			return "", false
+		}
+	}
+
+	if text, ok := value.(string); ok {
+		return text, true
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+
+	return string(encoded), true
+}
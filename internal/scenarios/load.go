@@ -0,0 +1,51 @@
+package scenarios
+
+import (
+	"fmt"
+	"load-generation-system/internal/core"
+	"os"
+	"path/filepath"
+)
+
+// LoadDefinitions reads the declarative scenarios of a directory and adds them to AvailableScenarios.
+// Every file with the .json extension holds a single definition. Nothing is registered if one of
+// the definitions is invalid or reuses the name of another scenario.
+//
+// Parameters:
+//   - dir: Directory of the definitions
+//
+// Returns:
+//   - error: The read error, or core.ErrBadScenario naming the invalid definition file
+func LoadDefinitions(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]Scenario, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		definition, err := ParseDefinition(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		if _, exists := AvailableScenarios[definition.Name]; exists {
+			return fmt.Errorf("%s: %w: scenario %s already exists", file, core.ErrBadScenario, definition.Name)
+		}
+		if _, exists := loaded[definition.Name]; exists {
+			return fmt.Errorf("%s: %w: scenario %s already exists", file, core.ErrBadScenario, definition.Name)
+		}
+		loaded[definition.Name] = definition.Scenario()
+	}
+
+	for name, scenario := range loaded {
+		AvailableScenarios[name] = scenario
+	}
+
+	return nil
+}
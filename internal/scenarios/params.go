@@ -0,0 +1,97 @@
+package scenarios
+
+import (
+	"fmt"
+	"load-generation-system/internal/core"
+	"math"
+	"slices"
+)
+
+// ConvertParam checks a parameter value against the schema of the parameter and converts it to the parameter type.
+// Values decoded from JSON are accepted, so integers may come as whole float64 numbers.
+//
+// Parameters:
+//   - parameter: Schema of the parameter
+//   - value: The value to convert
+//
+// Returns:
+//   - any: The value as a string, an int64, a float64 or a bool
+//   - error: core.ErrBadParams if the value does not match the parameter type
+func ConvertParam(parameter core.ScenarioParameter, value any) (any, error) {
+	switch parameter.Type {
+	case core.ParameterString:
+		if text, ok := value.(string); ok {
+			return text, nil
+		}
+	case core.ParameterInt:
+		switch number := value.(type) {
+		case int64:
+			return number, nil
+		case int:
+			return int64(number), nil
+		case float64:
+			if number == math.Trunc(number) && math.Abs(number) <= 1<<53 {
+				return int64(number), nil
+			}
+		}
+	case core.ParameterFloat:
+		switch number := value.(type) {
+		case float64:
+			return number, nil
+		case int64:
+			return float64(number), nil
+		case int:
+			return float64(number), nil
+		}
+	case core.ParameterBool:
+		if flag, ok := value.(bool); ok {
+			return flag, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: parameter %s has unknown type %q", core.ErrBadParams, parameter.Name, parameter.Type)
+	}
+
+	return nil, fmt.Errorf("%w: parameter %s must be %s, not %v", core.ErrBadParams, parameter.Name, parameter.Type, value)
+}
+
+// ResolveParams checks the parameters given to a scenario against its schema and applies the defaults.
+//
+// Parameters:
+//   - parameters: Schema of the parameters of the scenario
+//   - params: The given parameters, may be nil
+//
+// Returns:
+//   - core.Params: The parameters converted to their types, with the defaults of the unset ones
+//   - error: core.ErrBadParams if a parameter is unknown, has a wrong type or is required but unset
+func ResolveParams(parameters []core.ScenarioParameter, params core.Params) (core.Params, error) {
+	for name := range params {
+		known := slices.ContainsFunc(parameters, func(parameter core.ScenarioParameter) bool {
+			return parameter.Name == name
+		})
+		if !known {
+			return nil, fmt.Errorf("%w: unknown parameter %s", core.ErrBadParams, name)
+		}
+	}
+
+	resolved := make(core.Params, len(parameters))
+	for _, parameter := range parameters {
+		value, set := params[parameter.Name]
+		if !set || value == nil {
+			value = parameter.Default
+		}
+		if value == nil {
+			if parameter.Required {
+				return nil, fmt.Errorf("%w: parameter %s is required", core.ErrBadParams, parameter.Name)
+			}
+			continue
+		}
+
+		converted, err := ConvertParam(parameter, value)
+		if err != nil {
+			return nil, err
+		}
+		resolved[parameter.Name] = converted
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,76 @@
+package scenarios
+
+import (
+	"fmt"
+	"load-generation-system/internal/core"
+	"sync"
+)
+
+var (
+	// uploaded holds the scenarios uploaded through the manager at runtime, next to AvailableScenarios.
+	uploaded = make(map[string]Scenario)
+
+	// uploadedMu protects the uploaded scenarios, as they are registered while the attacks run.
+	uploadedMu sync.RWMutex
+)
+
+// Register adds an uploaded scenario, replacing its previous version. The users already running
+// the previous version keep it, the users created afterwards run the new one.
+//
+// Parameters:
+//   - scenario: The scenario to register
+//
+// Returns:
+//   - error: core.ErrScenarioExists if the scenario would replace one of AvailableScenarios
+func Register(scenario Scenario) error {
+	if _, exists := AvailableScenarios[scenario.Name]; exists {
+		return fmt.Errorf("%w: %s is not an uploaded scenario", core.ErrScenarioExists, scenario.Name)
+	}
+
+	uploadedMu.Lock()
+	defer uploadedMu.Unlock()
+
+	uploaded[scenario.Name] = scenario
+
+	return nil
+}
+
+// Lookup finds a scenario by its name among AvailableScenarios and the uploaded ones.
+//
+// Parameters:
+//   - name: Name of the scenario
+//
+// Returns:
+//   - Scenario: The found scenario
+//   - bool: false if no scenario has the name
+func Lookup(name string) (Scenario, bool) {
+	if scenario, exists := AvailableScenarios[name]; exists {
+		return scenario, true
+	}
+
+	uploadedMu.RLock()
+	defer uploadedMu.RUnlock()
+
+	scenario, exists := uploaded[name]
+
+	return scenario, exists
+}
+
+// List returns AvailableScenarios along with the uploaded scenarios.
+//
+// Returns:
+//   - []Scenario: All the scenarios the node can run
+func List() []Scenario {
+	uploadedMu.RLock()
+	defer uploadedMu.RUnlock()
+
+	scenarios := make([]Scenario, 0, len(AvailableScenarios)+len(uploaded))
+	for _, scenario := range AvailableScenarios {
+		scenarios = append(scenarios, scenario)
+	}
+	for _, scenario := range uploaded {
+		scenarios = append(scenarios, scenario)
+	}
+
+	return scenarios
+}
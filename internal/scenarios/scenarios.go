@@ -2,6 +2,7 @@ package scenarios
 
 import (
 	"context"
+	"load-generation-system/internal/core"
 	"load-generation-system/internal/service/callers"
 )
 
@@ -17,6 +18,27 @@ type Scenario struct {
 	// Commands is a function that takes a context and a caller object, and executes a series of actions or commands.
 	// It is expected to return an error if something goes wrong during the scenario execution.
 	Commands func(ctx context.Context, caller *callers.Caller) error
+
+	// Setup is an optional function run once for every user of the scenario before its first iteration,
+	// such as logging in and keeping the session in caller.State. A failed setup is counted apart from the iterations
+	// and retried before the next one, and its requests are not part of the request metrics.
+	Setup func(ctx context.Context, caller *callers.Caller) error
+
+	// Teardown is an optional function run once for every user of the scenario when the user is destroyed,
+	// after its last iteration is over. It releases whatever the user has acquired in the target services.
+	// The users whose setup has not succeeded are not torn down.
+	Teardown func(ctx context.Context, caller *callers.Caller) error
+
+	// AttackSetup is an optional function run once per attack, by a single node, before the users of the scenario
+	// start their iterations on any node, such as seeding fixtures. If it fails, the users of the scenario do not run.
+	AttackSetup func(ctx context.Context, caller *callers.Caller) error
+
+	// AttackTeardown is an optional function run once the attack is over, by the node that has run the attack setup
+	// successfully, with the same caller. It removes what the attack setup has created.
+	AttackTeardown func(ctx context.Context, caller *callers.Caller) error
+
+	// Parameters is the schema of the parameters the commands read from caller.State.Params.
+	Parameters []core.ScenarioParameter
 }
 
 // New is a constructor function that creates and returns a new Scenario instance.
@@ -32,6 +54,32 @@ func New(
 	}
 }
 
+// WithSetup returns a copy of the scenario running the given setup for every user before its first iteration.
+func (s Scenario) WithSetup(setup func(ctx context.Context, caller *callers.Caller) error) Scenario {
+	s.Setup = setup
+	return s
+}
+
+// WithTeardown returns a copy of the scenario running the given teardown for every destroyed user.
+func (s Scenario) WithTeardown(teardown func(ctx context.Context, caller *callers.Caller) error) Scenario {
+	s.Teardown = teardown
+	return s
+}
+
+// WithAttackHooks returns a copy of the scenario running the given setup once per attack before its users start,
+// and the given teardown once the attack is over. Either of them may be nil.
+func (s Scenario) WithAttackHooks(setup, teardown func(ctx context.Context, caller *callers.Caller) error) Scenario {
+	s.AttackSetup = setup
+	s.AttackTeardown = teardown
+	return s
+}
+
+// WithParameters returns a copy of the scenario accepting the given parameters from the attacks.
+func (s Scenario) WithParameters(parameters ...core.ScenarioParameter) Scenario {
+	s.Parameters = parameters
+	return s
+}
+
 // AvailableScenarios is a map that holds predefined load generation scenarios.
 // The key is the scenario name, and the value is the Scenario struct that contains its details and commands.
 var (
@@ -7,6 +7,9 @@ import (
 	"time"
 )
 
+// maxSummaries bounds the number of the most recent ended attacks whose summaries are kept.
+const maxSummaries = 1000
+
 // attackService implements the core.AttackService interface and manages the
 // complete lifecycle of load test attacks across distributed nodes.
 //
@@ -16,16 +19,36 @@ import (
 //   - attacks: Active attacks indexed by attack ID
 //   - attackSeq: Sequence counter for generating unique attack IDs
 //   - incrementSeqs: Sequence counters for generating increment IDs per attack
+//   - stats: Request statistics reported by the nodes per attack since the last take
+//   - totals: Request statistics reported by the nodes per attack since its start
+//   - waiters: Channels waiting for the summaries of the attacks
+//   - summaries: Summaries of the most recent ended attacks
+//   - ended: IDs of the attacks whose summaries are kept, in the order they ended
+//   - uploads: Latest versions of the uploaded scenarios, pushed to every node that connects
+//   - targetService: Service providing the targets the attacks are run against
+//   - datasetService: Service providing the datasets the attacks feed their users from
+//   - leaseService: Service holding the lease pools, whose leases are released when a node is given up
+//   - pendingFeeds: Dataset rows of an attack waiting to be split between the nodes of its first operation
 //   - recoveryInterval: Duration between recovery attempts for failed operations
 //   - mu: Read-write mutex for concurrent access protection
 type attackService struct {
-	nodes            map[string]core.Node // Active worker nodes
-	removingCancels  map[string]chan any  // Node removal cancellation channels
-	attacks          map[int64]attack     // Active attacks
-	attackSeq        int64                // Attack ID sequence counter
-	incrementSeqs    map[int64]int64      // Increment ID sequences per attack
-	recoveryInterval time.Duration        // Recovery retry interval
-	mu               sync.RWMutex         // Concurrency control
+	nodes            map[string]core.Node                // Active worker nodes
+	removingCancels  map[string]chan any                 // Node removal cancellation channels
+	attacks          map[int64]attack                    // Active attacks
+	attackSeq        int64                               // Attack ID sequence counter
+	incrementSeqs    map[int64]int64                     // Increment ID sequences per attack
+	stats            map[int64]core.AttackStats          // Request statistics per attack since the last take
+	totals           map[int64]core.AttackStats          // Request statistics per attack since its start
+	waiters          map[int64][]chan core.AttackSummary // Attack end waiters
+	summaries        map[int64]core.AttackSummary        // Ended attack summaries
+	ended            []int64                             // Ended attack order
+	uploads          map[string]core.OperationScenario   // Uploaded scenarios
+	targetService    core.TargetService                  // Attack targets
+	datasetService   core.DatasetService                 // Attack datasets
+	leaseService     core.LeaseService                   // Lease pools
+	pendingFeeds     map[int64][]core.FeedShare          // Undivided dataset rows
+	recoveryInterval time.Duration                       // Recovery retry interval
+	mu               sync.RWMutex                        // Concurrency control
 }
 
 // attack represents a single load test attack with its configuration and control mechanisms.
@@ -33,17 +56,39 @@ type attackService struct {
 // Fields:
 //   - details: Configuration and metadata of the attack
 //   - stopBr: Broadcast channel for stopping the attack across all nodes
+//   - pause: Paused state shared with the attack handlers
+//   - completions: Iterations reported by the nodes per increment of an iteration-bounded attack
+//   - setups: Attack-level setups of the scenarios claimed by the nodes, indexed by scenario name
+//   - holdsEmpty: Whether the attack keeps running without increments, as its load profile ends it
 type attack struct {
-	details core.AttackDetails          // Attack parameters and state
-	stopBr  *broadcast.Broadcaster[any] // Attack stop signal broadcaster
+	details     core.AttackDetails                  // Attack parameters and state
+	stopBr      *broadcast.Broadcaster[any]         // Attack stop signal broadcaster
+	pause       *pauseGate                          // Attack pause state
+	completions map[int64]map[string]nodeCompletion // Increment completions per node
+	setups      map[string]*setup                   // Attack-level setups per scenario
+	holdsEmpty  bool                                // Zero load hold
 }
 
-func NewService(recoveryIntervalSec int64) core.AttackService {
+func NewService(
+	targetService core.TargetService,
+	datasetService core.DatasetService,
+	leaseService core.LeaseService,
+	recoveryIntervalSec int64,
+) core.AttackService {
 	return &attackService{
 		nodes:            make(map[string]core.Node),
 		removingCancels:  make(map[string]chan any),
 		attacks:          make(map[int64]attack),
 		incrementSeqs:    make(map[int64]int64),
+		stats:            make(map[int64]core.AttackStats),
+		totals:           make(map[int64]core.AttackStats),
+		waiters:          make(map[int64][]chan core.AttackSummary),
+		summaries:        make(map[int64]core.AttackSummary),
+		uploads:          make(map[string]core.OperationScenario),
+		targetService:    targetService,
+		datasetService:   datasetService,
+		leaseService:     leaseService,
+		pendingFeeds:     make(map[int64][]core.FeedShare),
 		recoveryInterval: time.Duration(recoveryIntervalSec) * time.Second,
 	}
 }
@@ -0,0 +1,166 @@
+package attack
+
+import (
+	"cmp"
+	"load-generation-system/internal/core"
+	"log"
+	"slices"
+)
+
+// nodeCompletion holds the last completion report of a node for an increment.
+type nodeCompletion struct {
+	iterations int64 // Number of iterations completed by the node
+	done       bool  // Whether the node has run all the iterations it was given
+}
+
+// CompleteIncrement records that a node has run all the iterations of an increment.
+// The increment is stopped once every node holding it has reported its completion,
+// which ends the attack together with its last increment.
+//
+// Parameters:
+//   - nodeName: Name of the reporting node
+//   - completion: The completion report
+//
+// Reports of the attacks and increments that no longer exist are ignored.
+func (s *attackService) CompleteIncrement(nodeName string, completion core.IncrementCompletion) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[completion.AttackID]
+	if !exists {
+		return
+	}
+
+	i := slices.IndexFunc(attack.details.Increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == completion.IncrementID
+	})
+	if i == -1 {
+		return
+	}
+
+	completions := attack.completions[completion.IncrementID]
+	if completions == nil {
+		completions = make(map[string]nodeCompletion)
+		attack.completions[completion.IncrementID] = completions
+	}
+	completions[nodeName] = nodeCompletion{
+		iterations: completion.Iterations,
+		done:       true,
+	}
+
+	// Update the completed iterations of the increment
+	var completed int64
+	for _, nodeCompletion := range completions {
+		completed += nodeCompletion.iterations
+	}
+	increments := slices.Clone(attack.details.Increments)
+	increments[i].CompletedIterations = completed
+	attack.details.Increments = increments
+	s.attacks[completion.AttackID] = attack
+
+	// Wait for the other nodes still running the increment
+	for name, node := range s.nodes {
+		if completions[name].done {
+			continue
+		}
+		if holdsIncrement(node, completion.AttackID, completion.IncrementID) {
+			return
+		}
+	}
+
+	if err := s.stopIncrement(completion.AttackID, completion.IncrementID, core.StopOptions{}); err != nil {
+		log.Printf("impossible to stop completed increment: %v", err)
+	}
+}
+
+// reopenIncrement marks an increment as not completed by a node that has been given more work in it.
+//
+// Parameters:
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment
+//   - nodeName: Name of the node
+//
+// Must be called with s.mu held.
+func (s *attackService) reopenIncrement(attackID, incrementID int64, nodeName string) {
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return
+	}
+
+	if completion, exists := attack.completions[incrementID][nodeName]; exists {
+		completion.done = false
+		attack.completions[incrementID][nodeName] = completion
+	}
+}
+
+// holdsIncrement checks whether a node runs an increment.
+//
+// Parameters:
+//   - node: The node to check
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment
+//
+// Returns:
+//   - bool: Whether the increment is assigned to the node
+func holdsIncrement(node core.Node, attackID, incrementID int64) bool {
+	for _, attack := range node.GetDetails().Attacks {
+		if attack.ID != attackID {
+			continue
+		}
+		for _, increment := range attack.Increments {
+			if increment.ID == incrementID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// divideIterations splits an iteration budget across the node operations in proportion
+// to the users each of them holds. The remainder goes to the nodes with the largest fractional shares.
+//
+// Parameters:
+//   - iterations: The budget to split
+//   - operations: Operations per node, updated in place
+func divideIterations(iterations int64, operations map[string]core.OperationStart) {
+	var users int64
+	nodes := make([]string, 0, len(operations))
+	for nodeName, operation := range operations {
+		for _, counter := range operation.Scenarios {
+			users += counter
+		}
+		nodes = append(nodes, nodeName)
+	}
+	if users == 0 {
+		return
+	}
+	slices.Sort(nodes)
+
+	shares := make(map[string]int64, len(nodes))
+	remainders := make(map[string]int64, len(nodes))
+	var assigned int64
+	for _, nodeName := range nodes {
+		var nodeUsers int64
+		for _, counter := range operations[nodeName].Scenarios {
+			nodeUsers += counter
+		}
+		shares[nodeName] = iterations * nodeUsers / users
+		remainders[nodeName] = iterations * nodeUsers % users
+		assigned += shares[nodeName]
+	}
+
+	slices.SortStableFunc(nodes, func(a, b string) int {
+		return cmp.Compare(remainders[b], remainders[a])
+	})
+	for _, nodeName := range nodes[:iterations-assigned] {
+		shares[nodeName]++
+	}
+
+	for _, nodeName := range nodes {
+		share := shares[nodeName]
+		operation := operations[nodeName]
+		operation.Iterations = &share
+		operations[nodeName] = operation
+	}
+}
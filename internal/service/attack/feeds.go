@@ -0,0 +1,123 @@
+package attack
+
+import (
+	"load-generation-system/internal/core"
+	"slices"
+)
+
+// loadFeeds keeps the rows of the datasets fed to a new attack until its first operation is divided,
+// so that they are split in proportion to the users each node receives.
+//
+// Parameters:
+//   - attackID: ID of the new attack
+//   - feeds: Datasets fed to the attack
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrDatasetNotFound if a dataset doesn't exist
+//
+// Must be called with s.mu held.
+func (s *attackService) loadFeeds(attackID int64, feeds []core.Feed) error {
+	if len(feeds) == 0 {
+		return nil
+	}
+
+	pending := make([]core.FeedShare, 0, len(feeds))
+	for _, feed := range feeds {
+		dataset, err := s.datasetService.GetDataset(feed.Dataset)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, core.FeedShare{
+			Dataset: feed.Dataset,
+			Mode:    feed.Mode,
+			Rows:    dataset.Rows,
+		})
+	}
+	s.pendingFeeds[attackID] = pending
+
+	return nil
+}
+
+// divideFeeds attaches the dataset rows to the operations of the nodes.
+// The rows carried by the operation, such as the ones of a lost node, and the rows of the attack
+// waiting for its first operation are split in proportion to the users each node receives,
+// so that no unique row is handed out twice across the cluster.
+//
+// Parameters:
+//   - start: The divided operation
+//   - operations: Operations per node name, updated in place
+//
+// Must be called with s.mu held.
+func (s *attackService) divideFeeds(start core.OperationStart, operations map[string]core.OperationStart) {
+	var nodeNames []string
+	for nodeName, operation := range operations {
+		if len(operation.Scenarios) != 0 {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	slices.Sort(nodeNames)
+
+	weights := make([]int64, len(nodeNames))
+	for i, nodeName := range nodeNames {
+		for _, counter := range operations[nodeName].Scenarios {
+			weights[i] += counter
+		}
+	}
+
+	shares := append(slices.Clone(start.Feeds), s.pendingFeeds[start.AttackID]...)
+	delete(s.pendingFeeds, start.AttackID)
+
+	for i, nodeName := range nodeNames {
+		operation := operations[nodeName]
+		for _, share := range shares {
+			rows := splitRows(share.Rows, share.Mode, weights)[i]
+			if len(rows) != 0 {
+				operation.Feeds = append(operation.Feeds, core.FeedShare{
+					Dataset: share.Dataset,
+					Mode:    share.Mode,
+					Rows:    rows,
+				})
+			}
+		}
+		operations[nodeName] = operation
+	}
+}
+
+// splitRows splits the rows of a dataset into contiguous parts in proportion to the given weights.
+// Every part of a random feed holds all the rows.
+//
+// Parameters:
+//   - rows: Rows to split
+//   - mode: The way the rows are handed out
+//   - weights: Weight of each part
+//
+// Returns:
+//   - [][]core.DataRow: Parts of the rows, one per weight
+func splitRows(rows []core.DataRow, mode core.FeedMode, weights []int64) [][]core.DataRow {
+	parts := make([][]core.DataRow, len(weights))
+	if mode == core.FeedRandom {
+		for i := range parts {
+			parts[i] = rows
+		}
+		return parts
+	}
+
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+	if total == 0 {
+		return parts
+	}
+
+	var from, cumulative int64
+	for i, weight := range weights {
+		cumulative += weight
+		to := int64(len(rows)) * cumulative / total
+		parts[i] = rows[from:to]
+		from = to
+	}
+
+	return parts
+}
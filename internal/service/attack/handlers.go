@@ -4,6 +4,7 @@ import (
 	"load-generation-system/internal/core"
 	"log"
 	"math"
+	"slices"
 	"time"
 )
 
@@ -13,22 +14,18 @@ import (
 // Parameters:
 //   - attack: The attack configuration containing duration and stop broadcaster
 func (s *attackService) handleDuration(attack attack) {
-	// Create timer with attack duration
-	timer := time.NewTimer(time.Duration(*attack.details.DurationSec) * time.Second)
-	defer timer.Stop()
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
 
-	for {
-		select {
-		case <-timer.C:
-			// Duration elapsed - stop the attack
-			if err := s.StopAttack(attack.details.ID); err != nil {
-				log.Printf("error stopping attack %d: %v", attack.details.ID, err)
-			}
-			return
-		case <-attack.stopBr.Subscribe():
-			// Stop signal received
-			return
-		}
+	// Wait for the attack duration, the time spent paused is not counted
+	if !s.sleep(stop, attack.pause, time.Duration(*attack.details.DurationSec)*time.Second) {
+		// Stop signal received
+		return
+	}
+
+	// Duration elapsed - stop the attack
+	if err := s.StopAttack(attack.details.ID, core.StopOptions{}); err != nil {
+		log.Printf("error stopping attack %d: %v", attack.details.ID, err)
 	}
 }
 
@@ -83,58 +80,595 @@ func (s *attackService) handleLinear(attack attack) {
 	currentCounter := startCounter
 	var totalElapsedTime float64
 
-	// Initialize timer with computed interval
-	timer := time.NewTimer(time.Duration(computedInterval * float64(time.Second)))
-	defer timer.Stop()
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
 
 	for {
-		select {
-		case <-timer.C:
-			// Check if we've reached the target load
-			if currentCounter >= endCounter {
-				return
+		// Wait for the computed interval, the time spent paused is not counted
+		if !s.sleep(stop, attack.pause, time.Duration(computedInterval*float64(time.Second))) {
+			// Stop signal received
+			return
+		}
+
+		// Check if we've reached the target load
+		if currentCounter >= endCounter {
+			return
+		}
+
+		// Dynamic step adjustment when only duration is specified
+		if step == nil {
+			computedStep = int64(math.Ceil(float64(endCounter-currentCounter) *
+				computedInterval / (float64(*duration) - totalElapsedTime)))
+		} else if computedStep > endCounter-currentCounter {
+			// Adjust final step to exactly reach endCounter
+			computedStep = endCounter - currentCounter
+		}
+
+		// Prepare scenarios with computed step size
+		scenarios := make(map[string]int64)
+		for _, scenario := range attack.details.LinearConfig.Scenarios {
+			scenarios[scenario.Name] = computedStep
+		}
+
+		// Start new increment with calculated load
+		incrementStart := core.OperationStart{
+			AttackID:  attack.details.ID,
+			Scenarios: scenarios,
+		}
+		if _, err := s.StartIncrement(incrementStart, nil); err != nil {
+			log.Printf("error starting increment: %v", err)
+			return
+		}
+
+		// Update tracking variables
+		totalElapsedTime += computedInterval
+		currentCounter += computedStep
+
+		// Adjust interval for final step if needed
+		if duration != nil && totalElapsedTime+computedInterval > float64(*duration) {
+			computedInterval = float64(*duration) - totalElapsedTime
+		}
+	}
+}
+
+// handleRampDown gradually retires the load of a linear attack before its duration elapses.
+// The counter of every linear scenario shrinks evenly each second, the newest increments being retired first.
+//
+// Parameters:
+//   - attack: The attack configuration containing duration and ramp-down parameters
+//
+// The remaining load is stopped together with the attack once its duration elapses.
+func (s *attackService) handleRampDown(attack attack) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
+
+	rampDown := *attack.details.LinearConfig.RampDownSec
+	if !s.sleep(stop, attack.pause, time.Duration(*attack.details.DurationSec-rampDown)*time.Second) {
+		return
+	}
+
+	// Remember the load reached at the beginning of the ramp-down
+	s.mu.Lock()
+	active, exists := s.attacks[attack.details.ID]
+	if !exists {
+		s.mu.Unlock()
+		return
+	}
+	current := s.currentLoad(active)
+	s.mu.Unlock()
+
+	initial := make(map[string]int64, len(attack.details.LinearConfig.Scenarios))
+	for _, scenario := range attack.details.LinearConfig.Scenarios {
+		initial[scenario.Name] = current[scenario.Name]
+	}
+
+	for second := int64(1); second < rampDown; second++ {
+		if !s.sleep(stop, attack.pause, time.Second) {
+			return
+		}
+
+		target := make(map[string]int64, len(initial))
+		for scenario, counter := range initial {
+			target[scenario] = int64(math.Ceil(float64(counter*(rampDown-second)) / float64(rampDown)))
+		}
+
+		s.mu.Lock()
+		err := s.adjustLoad(attack.details.ID, target)
+		s.mu.Unlock()
+		if err != nil {
+			log.Printf("error ramping down attack %d: %v", attack.details.ID, err)
+			return
+		}
+	}
+}
+
+// handleStages drives a staged attack through its ordered list of stages.
+// Each stage moves the counter of every scenario to the stage target, either
+// evenly over the stage duration (linear ramp) or at once (step ramp).
+//
+// Parameters:
+//   - attack: The attack configuration containing the stages
+//
+// The attack is stopped once the last stage is over. A stage dropping the counter to zero
+// holds the attack without users until a later stage brings them back.
+func (s *attackService) handleStages(attack attack) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
+
+	config := attack.details.StagesConfig
+	currentCounter := config.StartCounter
+
+	for i, stage := range config.Stages {
+		if !s.updateDetails(attack.details.ID, func(details *core.AttackDetails) {
+			details.ActiveStage = &i
+		}) {
+			return
+		}
+
+		startCounter := currentCounter
+		for second := int64(1); second <= stage.DurationSec; second++ {
+			// Step ramp moves to the target at the start, linear one - evenly every second
+			counter := stage.TargetCounter
+			if stage.Ramp != core.RampStep {
+				progress := float64(second) / float64(stage.DurationSec)
+				counter = startCounter + int64(math.Round(float64(stage.TargetCounter-startCounter)*progress))
 			}
 
-			// Dynamic step adjustment when only duration is specified
-			if step == nil {
-				computedStep = int64(math.Ceil(float64(endCounter-currentCounter) *
-					computedInterval / (float64(*duration) - totalElapsedTime)))
-			} else if computedStep > endCounter-currentCounter {
-				// Adjust final step to exactly reach endCounter
-				computedStep = endCounter - currentCounter
+			if counter != currentCounter {
+				if err := s.setCounter(attack.details.ID, config.Scenarios, counter); err != nil {
+					log.Printf("error moving attack %d to stage %d: %v", attack.details.ID, i, err)
+					return
+				}
+				currentCounter = counter
+			}
+
+			if !s.sleep(stop, attack.pause, time.Second) {
+				return
 			}
+		}
+	}
+
+	// All stages are over - stop the attack
+	if err := s.StopAttack(attack.details.ID, core.StopOptions{}); err != nil {
+		log.Printf("error stopping attack %d: %v", attack.details.ID, err)
+	}
+}
+
+// handleSpikes runs the bursts of a spike attack on top of its baseline load.
+// Every spike is a separate increment, so it is started and stopped on all nodes at once.
+//
+// Parameters:
+//   - attack: The attack configuration containing the spike parameters
+//
+// The baseline load keeps running after the last spike until the attack is stopped.
+func (s *attackService) handleSpikes(attack attack) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
+
+	config := attack.details.SpikeConfig
+	scenarios := make(map[string]int64, len(config.Scenarios))
+	for _, scenario := range config.Scenarios {
+		scenarios[scenario.Name] = config.SpikeCounter
+	}
+
+	for i := int64(0); i < config.SpikeCount; i++ {
+		if !s.sleep(stop, attack.pause, time.Duration(config.IntervalSec)*time.Second) {
+			return
+		}
+
+		incrementID, err := s.startSpike(attack.details.ID, scenarios)
+		if err != nil {
+			log.Printf("error starting spike %d of attack %d: %v", i, attack.details.ID, err)
+			return
+		}
+
+		if !s.sleep(stop, attack.pause, time.Duration(config.SpikeDurationSec)*time.Second) {
+			return
+		}
+
+		if err := s.endSpike(attack.details.ID, incrementID); err != nil {
+			log.Printf("error ending spike %d of attack %d: %v", i, attack.details.ID, err)
+			return
+		}
+	}
+}
+
+// startSpike starts the increment of a new spike and records its start time.
+//
+// Parameters:
+//   - attackID: ID of the spike attack
+//   - scenarios: Map of scenario names to the spike counters
+//
+// Returns:
+//   - int64: ID of the increment holding the spike users
+//   - error: Errors from increment start
+func (s *attackService) startSpike(attackID int64, scenarios map[string]int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	increment, err := s.startIncrement(core.OperationStart{
+		AttackID:  attackID,
+		Scenarios: scenarios,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	attack := s.attacks[attackID]
+	attack.details.Spikes = append(slices.Clone(attack.details.Spikes), core.SpikeDetails{
+		IncrementID: increment.ID,
+		StartedAt:   time.Now().UTC(),
+	})
+	s.attacks[attackID] = attack
+
+	return increment.ID, nil
+}
+
+// endSpike stops the increment of a running spike and records its end time.
+//
+// Parameters:
+//   - attackID: ID of the spike attack
+//   - incrementID: ID of the increment holding the spike users
+//
+// Returns:
+//   - error: Errors from increment stop
+func (s *attackService) endSpike(attackID, incrementID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.stopIncrement(attackID, incrementID, core.StopOptions{}); err != nil {
+		return err
+	}
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return nil
+	}
+
+	endedAt := time.Now().UTC()
+	spikes := slices.Clone(attack.details.Spikes)
+	for i := range spikes {
+		if spikes[i].IncrementID == incrementID {
+			spikes[i].EndedAt = &endedAt
+		}
+	}
+	attack.details.Spikes = spikes
+	s.attacks[attackID] = attack
+
+	return nil
+}
+
+// handlePeriodic moves the counter of a periodic attack along its curve.
+// The curve is re-sampled every sample interval, and the load is adjusted to the sampled level.
+//
+// Parameters:
+//   - attack: The attack configuration containing the curve parameters
+//
+// The curve repeats until the attack duration elapses or the attack is stopped.
+func (s *attackService) handlePeriodic(attack attack) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
 
-			// Prepare scenarios with computed step size
-			scenarios := make(map[string]int64)
-			for _, scenario := range attack.details.LinearConfig.Scenarios {
-				scenarios[scenario.Name] = computedStep
+	config := attack.details.PeriodicConfig
+	currentCounter := periodicCounter(config, 0)
+	interval := time.Duration(config.SampleIntervalSec) * time.Second
+
+	// The curve position is driven by the slept intervals, so it stands still while the attack is paused
+	var elapsed time.Duration
+	for {
+		counter := periodicCounter(config, elapsed)
+		if counter != currentCounter {
+			if err := s.setCounter(attack.details.ID, config.Scenarios, counter); err != nil {
+				log.Printf("error moving attack %d along its curve: %v", attack.details.ID, err)
+				return
 			}
+			currentCounter = counter
+		}
+
+		if !s.updateDetails(attack.details.ID, func(details *core.AttackDetails) {
+			details.TargetCounter = &counter
+		}) {
+			return
+		}
+
+		if !s.sleep(stop, attack.pause, interval) {
+			return
+		}
+		elapsed += interval
+	}
+}
+
+// periodicCounter samples the curve of a periodic attack.
+//
+// Parameters:
+//   - config: The periodic attack configuration
+//   - elapsed: Time the attack has been running for, excluding pauses
+//
+// Returns:
+//   - int64: The counter value at the given moment
+func periodicCounter(config *core.PeriodicConfig, elapsed time.Duration) int64 {
+	period := float64(config.PeriodSec)
+	position := math.Mod(elapsed.Seconds()+float64(config.PhaseSec), period) / period
+
+	var level float64
+	if len(config.Points) == 0 {
+		level = (1 + math.Sin(2*math.Pi*position)) / 2
+	} else {
+		// Interpolate between the neighbouring points, wrapping around the period end
+		index := position * float64(len(config.Points))
+		i := int(index) % len(config.Points)
+		next := config.Points[(i+1)%len(config.Points)]
+		level = config.Points[i] + (next-config.Points[i])*(index-math.Floor(index))
+	}
+
+	return config.MinCounter + int64(math.Round(float64(config.MaxCounter-config.MinCounter)*level))
+}
+
+// handleTrace replays the recorded time series of a trace attack.
+// Every point is applied at its offset divided by the time compression factor and holds until the next one.
+//
+// Parameters:
+//   - attack: The attack configuration containing the series
+//
+// In the users mode the counters are moved to the point values. In the RPS mode the increment
+// serving the arrival rates is rescaled in place to the point rates, keeping its users.
+// A point without users holds the attack at zero load until a later point brings them back.
+// The last point keeps running until the attack is stopped, unless it has no users.
+func (s *attackService) handleTrace(attack attack) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
+
+	config := attack.details.TraceConfig
+	incrementID := attack.details.Increments[0].ID
+
+	// Points are waited for one after another, so the replay stands still while the attack is paused
+	var replayed time.Duration
+	for i, point := range config.Points {
+		offset := time.Duration(point.OffsetSec / config.TimeCompression * float64(time.Second))
+		if !s.sleep(stop, attack.pause, offset-replayed) {
+			return
+		}
+		replayed = offset
 
-			// Start new increment with calculated load
-			incrementStart := core.OperationStart{
-				AttackID:  attack.details.ID,
-				Scenarios: scenarios,
+		// The first point is applied on the attack start
+		if i != 0 {
+			var err error
+			if config.Mode == core.LoadRPS {
+				err = s.setRates(attack.details.ID, incrementID, point.Values)
+			} else {
+				err = s.setValues(attack.details.ID, point)
 			}
-			if _, err := s.StartIncrement(incrementStart); err != nil {
-				log.Printf("error starting increment: %v", err)
+			if err != nil {
+				log.Printf("error replaying point %d of attack %d: %v", i, attack.details.ID, err)
 				return
 			}
+		}
+
+		if !s.updateDetails(attack.details.ID, func(details *core.AttackDetails) {
+			details.ActivePoint = &i
+		}) {
+			return
+		}
+	}
+
+	// The replay is over once the last point leaves no users
+	s.mu.RLock()
+	current, exists := s.attacks[attack.details.ID]
+	drained := exists && config.Mode != core.LoadRPS && len(current.details.Increments) == 0
+	s.mu.RUnlock()
+	if drained {
+		if err := s.StopAttack(attack.details.ID, core.StopOptions{}); err != nil {
+			log.Printf("error stopping attack %d: %v", attack.details.ID, err)
+		}
+	}
+}
+
+// handleAdaptive searches for the highest load an adaptive attack target sustains.
+// Every load value is observed for the step interval against the p95 latency and error rate thresholds.
+// The load grows by the step value until a threshold is breached, then the search bisects the gap
+// between the highest sustainable and the lowest failed values.
+//
+// Parameters:
+//   - attack: The attack configuration containing the search parameters
+//
+// Once the gap is not above the resolution, the attack keeps running at the sustainable load until
+// it is stopped. The attack is stopped if even the lowest observed load breaches the thresholds.
+func (s *attackService) handleAdaptive(attack attack) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
 
-			// Update tracking variables
-			totalElapsedTime += computedInterval
-			currentCounter += computedStep
+	config := attack.details.AdaptiveConfig
+	incrementID := attack.details.Increments[0].ID
+	value := config.StartValue
 
-			// Adjust interval for final step if needed
-			if duration != nil && totalElapsedTime+computedInterval > float64(*duration) {
-				computedInterval = float64(*duration) - totalElapsedTime
+	var sustainable int64
+	var failed *int64
+	for {
+		// Statistics gathered under the previous load are not relevant anymore
+		s.takeStats(attack.details.ID)
+		if !s.sleep(stop, attack.pause, time.Duration(config.StepIntervalSec)*time.Second) {
+			return
+		}
+
+		if adaptiveSustains(config, s.takeStats(attack.details.ID)) {
+			sustainable = value
+			if !s.updateDetails(attack.details.ID, func(details *core.AttackDetails) {
+				details.SustainableLoad = &sustainable
+			}) {
+				return
 			}
+		} else {
+			breached := value
+			failed = &breached
+		}
 
-			// Reset timer for next increment
-			timer.Reset(time.Duration(computedInterval * float64(time.Second)))
+		// Grow the load until the first breach, then bisect
+		var next int64
+		switch {
+		case failed == nil && config.MaxValue != nil && value >= *config.MaxValue:
+		case failed == nil:
+			next = value + config.StepValue
+			if config.MaxValue != nil {
+				next = min(next, *config.MaxValue)
+			}
+		case *failed-sustainable > config.Resolution:
+			next = (sustainable + *failed) / 2
+		}
+		if next == 0 {
+			break
+		}
 
-		case <-attack.stopBr.Subscribe():
-			// Stop signal received
+		if err := s.setAdaptiveLoad(attack.details.ID, incrementID, config, next); err != nil {
+			log.Printf("error moving attack %d to load %d: %v", attack.details.ID, next, err)
+			return
+		}
+		value = next
+	}
+
+	if sustainable == 0 {
+		log.Printf("attack %d breaches the thresholds at the lowest load %d", attack.details.ID, value)
+		if err := s.StopAttack(attack.details.ID, core.StopOptions{}); err != nil {
+			log.Printf("error stopping attack %d: %v", attack.details.ID, err)
+		}
+		return
+	}
+
+	if value != sustainable {
+		if err := s.setAdaptiveLoad(attack.details.ID, incrementID, config, sustainable); err != nil {
+			log.Printf("error moving attack %d to load %d: %v", attack.details.ID, sustainable, err)
 			return
 		}
 	}
+
+	s.updateDetails(attack.details.ID, func(details *core.AttackDetails) {
+		details.Converged = true
+	})
+}
+
+// setAdaptiveLoad moves every scenario of an adaptive attack to the same load value.
+//
+// Parameters:
+//   - attackID: ID of the attack to adjust
+//   - incrementID: ID of the increment serving the arrival rates in the RPS mode
+//   - config: The adaptive attack configuration
+//   - value: The target load value of each scenario
+//
+// Returns:
+//   - error: Errors from the load adjustment
+func (s *attackService) setAdaptiveLoad(attackID, incrementID int64, config *core.AdaptiveConfig, value int64) error {
+	if config.Mode != core.LoadRPS {
+		return s.setCounter(attackID, config.Scenarios, value)
+	}
+
+	rates := make(map[string]float64, len(config.Scenarios))
+	for _, scenario := range config.Scenarios {
+		rates[scenario.Name] = float64(value)
+	}
+
+	return s.setRates(attackID, incrementID, rates)
+}
+
+// adaptiveSustains checks the statistics of a search step against the adaptive attack thresholds.
+//
+// Parameters:
+//   - config: The adaptive attack configuration
+//   - stats: Statistics collected during the step
+//
+// Returns:
+//   - bool: true if the load is sustainable, a step without any completed requests is not
+func adaptiveSustains(config *core.AdaptiveConfig, stats core.AttackStats) bool {
+	if stats.Requests == 0 {
+		return false
+	}
+
+	return quantile(stats, 0.95)*1000 <= config.MaxP95Ms && errorRate(stats) <= config.MaxErrorRate
+}
+
+// setValues moves the counters of an attack to the values of a series point.
+//
+// Parameters:
+//   - attackID: ID of the attack to adjust
+//   - point: The series point holding the concurrent users of each scenario
+//
+// Returns:
+//   - error: Errors from the load adjustment
+func (s *attackService) setValues(attackID int64, point core.TracePoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target := make(map[string]int64, len(point.Values))
+	for scenario, value := range point.Values {
+		target[scenario] = int64(math.Round(value))
+	}
+
+	return s.adjustLoad(attackID, target)
+}
+
+// setRates moves the arrival rates of the increment serving them to constant values.
+// The increment is rescaled in place, so its users pools keep running along with the state of their users.
+//
+// Parameters:
+//   - attackID: ID of the attack to adjust
+//   - incrementID: ID of the increment serving the arrival rates
+//   - values: Map of scenario names to their arrival rates
+//
+// Returns:
+//   - error: Errors from the increment rescaling
+func (s *attackService) setRates(attackID, incrementID int64, values map[string]float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rates := make(map[string]core.ArrivalRate, len(values))
+	for scenario, value := range values {
+		rates[scenario] = core.ArrivalRate{
+			Rate:       value,
+			TargetRate: value,
+		}
+	}
+
+	return s.rescaleIncrement(attackID, incrementID, rates)
+}
+
+// setCounter moves every given scenario of an attack to the same counter value.
+//
+// Parameters:
+//   - attackID: ID of the attack to adjust
+//   - scenarios: Scenarios to move
+//   - counter: The target counter value of each scenario
+//
+// Returns:
+//   - error: Errors from the load adjustment
+func (s *attackService) setCounter(attackID int64, scenarios []core.Scenario, counter int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target := make(map[string]int64, len(scenarios))
+	for _, scenario := range scenarios {
+		target[scenario.Name] = counter
+	}
+
+	return s.adjustLoad(attackID, target)
+}
+
+// updateDetails applies a change to the details of an active attack.
+//
+// Parameters:
+//   - attackID: ID of the attack to update
+//   - update: Function modifying the attack details
+//
+// Returns:
+//   - bool: false if the attack doesn't exist anymore
+func (s *attackService) updateDetails(attackID int64, update func(details *core.AttackDetails)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return false
+	}
+
+	update(&attack.details)
+	s.attacks[attackID] = attack
+
+	return true
 }
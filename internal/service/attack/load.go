@@ -0,0 +1,119 @@
+package attack
+
+import (
+	"load-generation-system/internal/core"
+	"slices"
+	"sort"
+)
+
+// adjustLoad moves the total load of an attack to the target counters of the given scenarios.
+// Scenarios missing from the target map keep their current load.
+//
+// Parameters:
+//   - attackID: ID of the attack to adjust
+//   - target: Map of scenario names to the desired total counters
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - Errors from increment start and stop
+//
+// The method:
+// 1. Sums up the current counters of all attack increments
+// 2. Adds the missing load with a single new increment
+// 3. Retires the excess load starting from the newest increments
+//
+// Must be called with s.mu held.
+func (s *attackService) adjustLoad(attackID int64, target map[string]int64) error {
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	current := s.currentLoad(attack)
+
+	missing := make(map[string]int64)
+	excess := make(map[string]int64)
+	for scenario, counter := range target {
+		switch {
+		case counter > current[scenario]:
+			missing[scenario] = counter - current[scenario]
+		case counter < current[scenario]:
+			excess[scenario] = current[scenario] - counter
+		}
+	}
+
+	if len(missing) != 0 {
+		incrementStart := core.OperationStart{
+			AttackID:  attackID,
+			Scenarios: missing,
+		}
+		if _, err := s.startIncrement(incrementStart); err != nil {
+			return err
+		}
+	}
+
+	if len(excess) != 0 {
+		return s.retireLoad(attackID, excess)
+	}
+
+	return nil
+}
+
+// retireLoad removes the given amount of users from an attack, walking its increments
+// from the newest to the oldest one.
+//
+// Parameters:
+//   - attackID: ID of the attack to shrink
+//   - excess: Map of scenario names to the amount of users to retire
+//
+// Returns:
+//   - error: Errors from increment reduction
+//
+// Increments losing all of their users are stopped, the others are reduced in place.
+//
+// Must be called with s.mu held.
+func (s *attackService) retireLoad(attackID int64, excess map[string]int64) error {
+	increments := slices.Clone(s.attacks[attackID].details.Increments)
+	sort.Slice(increments, func(i, j int) bool {
+		return increments[i].ID > increments[j].ID
+	})
+
+	for _, increment := range increments {
+		retired := make(map[string]int64)
+		for scenario, counter := range increment.Scenarios {
+			if retire := min(excess[scenario], counter); retire > 0 {
+				excess[scenario] -= retire
+				retired[scenario] = retire
+			}
+		}
+
+		if len(retired) == 0 {
+			continue
+		}
+
+		if err := s.reduceIncrement(attackID, increment.ID, retired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// currentLoad sums up the counters of all increments of an attack.
+//
+// Parameters:
+//   - attack: The attack to inspect
+//
+// Returns:
+//   - map[string]int64: Map of scenario names to their total counters
+func (s *attackService) currentLoad(attack attack) map[string]int64 {
+	current := make(map[string]int64)
+	for _, increment := range attack.details.Increments {
+		for scenario, counter := range increment.Scenarios {
+			current[scenario] += counter
+		}
+	}
+
+	return current
+}
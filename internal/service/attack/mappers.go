@@ -2,6 +2,7 @@ package attack
 
 import (
 	"load-generation-system/internal/core"
+	"math"
 )
 
 func (s *attackService) mapStartAttackToOperationStart(start core.StartAttack, attackID, incrementID int64) core.OperationStart {
@@ -19,10 +20,112 @@ func (s *attackService) mapStartAttackToOperationStart(start core.StartAttack, a
 		}
 	}
 
+	if start.StagesConfig != nil {
+		for _, scenario := range start.StagesConfig.Scenarios {
+			resultScenarios[scenario.Name] += start.StagesConfig.StartCounter
+		}
+	}
+
+	if start.SpikeConfig != nil {
+		for _, scenario := range start.SpikeConfig.Scenarios {
+			resultScenarios[scenario.Name] += start.SpikeConfig.BaselineCounter
+		}
+	}
+
+	if start.PeriodicConfig != nil {
+		for _, scenario := range start.PeriodicConfig.Scenarios {
+			resultScenarios[scenario.Name] += periodicCounter(start.PeriodicConfig, 0)
+		}
+	}
+
+	resultRates := make(map[string]core.ArrivalRate)
+
+	if start.ArrivalRateConfig != nil {
+		for _, scenario := range start.ArrivalRateConfig.Scenarios {
+			rate := core.ArrivalRate{
+				Rate:       scenario.Rate,
+				TargetRate: scenario.Rate,
+			}
+			if scenario.TargetRate != nil && start.ArrivalRateConfig.RampUpSec != nil {
+				rate.TargetRate = *scenario.TargetRate
+				rate.RampUpSec = *start.ArrivalRateConfig.RampUpSec
+			}
+
+			resultScenarios[scenario.Name] = scenario.MaxUsers
+			resultRates[scenario.Name] = rate
+		}
+	}
+
+	if start.TraceConfig != nil {
+		for name, value := range start.TraceConfig.Points[0].Values {
+			if start.TraceConfig.Mode == core.LoadRPS {
+				resultScenarios[name] = start.TraceConfig.MaxUsers
+				resultRates[name] = core.ArrivalRate{
+					Rate:       value,
+					TargetRate: value,
+				}
+				continue
+			}
+
+			resultScenarios[name] += int64(math.Round(value))
+		}
+	}
+
+	if start.AdaptiveConfig != nil {
+		for _, scenario := range start.AdaptiveConfig.Scenarios {
+			if start.AdaptiveConfig.Mode == core.LoadRPS {
+				resultScenarios[scenario.Name] = start.AdaptiveConfig.MaxUsers
+				resultRates[scenario.Name] = core.ArrivalRate{
+					Rate:       float64(start.AdaptiveConfig.StartValue),
+					TargetRate: float64(start.AdaptiveConfig.StartValue),
+				}
+				continue
+			}
+
+			resultScenarios[scenario.Name] += start.AdaptiveConfig.StartValue
+		}
+	}
+
+	var iterationsPerUser int64
+	if start.IterationsPerUser != nil {
+		iterationsPerUser = *start.IterationsPerUser
+	}
+
 	return core.OperationStart{
-		AttackID:    attackID,
-		IncrementID: incrementID,
-		WaitTimeSec: start.WaitTimeSec,
-		Scenarios:   resultScenarios,
+		AttackID:          attackID,
+		IncrementID:       incrementID,
+		WaitTimeSec:       start.WaitTimeSec,
+		Scenarios:         resultScenarios,
+		ArrivalRates:      resultRates,
+		Pacings:           scenarioPacings(start.ConstConfig, start.LinearConfig),
+		Iterations:        start.Iterations,
+		IterationsPerUser: iterationsPerUser,
 	}
 }
+
+// scenarioPacings collects the pacing of the paced scenarios of constant and linear configurations.
+//
+// Parameters:
+//   - constConfig: Constant attack configuration, may be nil
+//   - linearConfig: Linear attack configuration, may be nil
+//
+// Returns:
+//   - map[string]core.Pacing: Pacing per paced scenario
+func scenarioPacings(constConfig *core.ConstConfig, linearConfig *core.LinearConfig) map[string]core.Pacing {
+	var scenarios []core.Scenario
+	if constConfig != nil {
+		scenarios = append(scenarios, constConfig.Scenarios...)
+	}
+	if linearConfig != nil {
+		scenarios = append(scenarios, linearConfig.Scenarios...)
+	}
+
+	pacings := make(map[string]core.Pacing)
+	for _, scenario := range scenarios {
+		if scenario.Pacing != nil {
+			pacings[scenario.Name] = *scenario.Pacing
+		}
+	}
+
+	return pacings
+}
@@ -18,9 +18,11 @@ import (
 //   - Errors from failed operation redistribution
 //
 // The method handles node recovery scenarios by:
-// 1. Checking for existing operations from previous nodes with same name
-// 2. Attempting to restart those operations on the new node
-// 3. Cleaning up any failed operation attempts
+// 1. Releasing the leases held by the users of the previous processes of the node
+// 2. Registering the uploaded scenarios on the node
+// 3. Checking for existing operations from previous nodes with same name
+// 4. Attempting to restart those operations on the new node
+// 5. Cleaning up any failed operation attempts
 func (s *attackService) AddNode(node core.Node) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -33,6 +35,16 @@ func (s *attackService) AddNode(node core.Node) error {
 		return core.ErrNodeAlreadyExists
 	}
 
+	// A restarted node has lost the users holding the leases of its previous process
+	s.leaseService.ReleaseStale(nodeDetails.Name, nodeDetails.Instance)
+
+	// Register the uploaded scenarios before the operations that may use them
+	for _, upload := range s.uploads {
+		if err := node.AddScenario(upload); err != nil {
+			log.Printf("impossible to add scenario %s on node %s: %v", upload.Name, nodeDetails.Name, err)
+		}
+	}
+
 	// Retrieve and restart any existing operations
 	operations := s.retrieveOperations(nodeDetails.Name)
 	for _, operation := range operations {
@@ -40,9 +52,18 @@ func (s *attackService) AddNode(node core.Node) error {
 			log.Printf("impossible to start attack on node %s: %v", nodeDetails.Name, err)
 
 			// Clean up failed operation
-			if err := s.stopIncrement(operation.AttackID, operation.IncrementID); err != nil {
+			if err := s.stopIncrement(operation.AttackID, operation.IncrementID, core.StopOptions{}); err != nil {
 				log.Printf("impossible to stop increment: %v", err)
 			}
+			continue
+		}
+		s.reopenIncrement(operation.AttackID, operation.IncrementID, nodeDetails.Name)
+
+		// Restore the pause of the recovered attacks
+		if s.attacks[operation.AttackID].details.Paused {
+			if err := node.PauseAttack(core.OperationPause{AttackID: operation.AttackID}); err != nil {
+				log.Printf("impossible to pause attack on node %s: %v", nodeDetails.Name, err)
+			}
 		}
 	}
 
@@ -73,10 +94,17 @@ func (s *attackService) retrieveOperations(nodeName string) []core.OperationStar
 			attackDetails := s.attacks[attack.ID].details
 			for _, increment := range attack.Increments {
 				operations = append(operations, core.OperationStart{
-					AttackID:    attack.ID,
-					IncrementID: increment.ID,
-					WaitTimeSec: attackDetails.WaitTimeSec,
-					Scenarios:   increment.Scenarios,
+					AttackID:          attack.ID,
+					IncrementID:       increment.ID,
+					WaitTimeSec:       attackDetails.WaitTimeSec,
+					Scenarios:         increment.Scenarios,
+					ArrivalRates:      increment.ArrivalRates,
+					Pacings:           increment.Pacings,
+					Iterations:        increment.Iterations,
+					IterationsPerUser: increment.IterationsPerUser,
+					Target:            attackDetails.Target,
+					Params:            attackDetails.Params,
+					Feeds:             increment.Feeds,
 				})
 			}
 		}
@@ -112,7 +140,8 @@ func (s *attackService) RemoveNode(node core.Node) error {
 // startRemovingTimer initiates the node removal process with a recovery window.
 // During the recovery interval:
 // - The node may reconnect and cancel the removal
-// - If interval elapses, operations are redistributed
+// - If interval elapses, operations are redistributed, the leases held by the node users are released,
+// and the attack-level setups run by the node are reopened
 //
 // Parameters:
 //   - nodeName: Name of the node being removed
@@ -129,8 +158,11 @@ func (s *attackService) startRemovingTimer(nodeName string) {
 		defer func() {
 			timer.Stop()
 
+			// A later removal of the node has its own channel
 			s.mu.Lock()
-			delete(s.removingCancels, nodeName)
+			if s.removingCancels[nodeName] == cancel {
+				delete(s.removingCancels, nodeName)
+			}
 			s.mu.Unlock()
 		}()
 
@@ -139,20 +171,35 @@ func (s *attackService) startRemovingTimer(nodeName string) {
 			// Removal was canceled (node reconnected)
 			return
 		case <-timer.C:
-			// Recovery period elapsed - redistribute operations
 			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			// The node may have reconnected while the lock was awaited
+			select {
+			case <-cancel:
+				return
+			default:
+			}
+			if s.removingCancels[nodeName] != cancel {
+				return
+			}
+
+			// Recovery period elapsed - the node users are gone along with their leases
+			s.leaseService.ReleaseNode(nodeName)
+
+			// Redistribute operations, the setups the node was running are left to the other nodes
+			s.reopenSetups(nodeName)
 			operations := s.retrieveOperations(nodeName)
 
 			for _, operation := range operations {
 				if err := s.distributeStart(operation); err != nil {
 					log.Printf("impossible to redistribute load: %v", err)
 
-					if err := s.stopIncrement(operation.AttackID, operation.IncrementID); err != nil {
+					if err := s.stopIncrement(operation.AttackID, operation.IncrementID, core.StopOptions{}); err != nil {
 						log.Printf("impossible to stop increment: %v", err)
 					}
 				}
 			}
-			s.mu.Unlock()
 		}
 	}()
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"maps"
 	"slices"
 
 	"github.com/google/uuid"
@@ -21,6 +22,9 @@ import (
 //   - core.AttackDetails: Details of the created attack
 //   - error: Possible errors:
 //   - core.ErrScenarioNotFound if invalid scenarios specified
+//   - core.ErrTargetNotFound if the target does not exist
+//   - core.ErrBadParams if the scenario parameters do not match the scenario schemas
+//   - core.ErrDatasetNotFound if a fed dataset does not exist
 //   - core.ErrEmptyAttack if no valid scenarios remain after validation
 //   - Errors from operation distribution
 //
@@ -33,8 +37,66 @@ func (s *attackService) StartAttack(start core.StartAttack) (core.AttackDetails,
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	return s.startAttack(start)
+}
+
+// StartAndWaitAttack starts a new attack and subscribes to its end at once,
+// so that an attack ending right after its start is not missed.
+//
+// Parameters:
+//   - start: Configuration for the new attack
+//
+// Returns:
+//   - core.AttackDetails: Details of the created attack
+//   - <-chan core.AttackSummary: Channel receiving the summary of the attack once it ends
+//   - error: Errors of StartAttack
+func (s *attackService) StartAndWaitAttack(start core.StartAttack) (core.AttackDetails, <-chan core.AttackSummary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attackDetails, err := s.startAttack(start)
+	if err != nil {
+		return core.AttackDetails{}, nil, err
+	}
+
+	return attackDetails, s.addWaiter(attackDetails.ID), nil
+}
+
+// startAttack creates a new attack and distributes its first increment, as described by StartAttack.
+//
+// Parameters:
+//   - start: Configuration for the new attack
+//
+// Returns:
+//   - core.AttackDetails: Details of the created attack
+//   - error: Errors of StartAttack
+//
+// Must be called with s.mu held.
+func (s *attackService) startAttack(start core.StartAttack) (core.AttackDetails, error) {
+	// The attack keeps a copy of its target, later changes of the target do not affect it
+	var target *core.Target
+	if start.Target != nil {
+		targetDetails, err := s.targetService.GetTarget(*start.Target)
+		if err != nil {
+			return core.AttackDetails{}, err
+		}
+		target = &targetDetails.Target
+	}
+
 	operationStart := s.mapStartAttackToOperationStart(start, s.attackSeq, 0)
+	operationStart.Target = target
+
+	params, err := s.resolveParams(start.Params, operationStart.Scenarios)
+	if err != nil {
+		return core.AttackDetails{}, err
+	}
+	operationStart.Params = params
+
+	if err := s.loadFeeds(operationStart.AttackID, start.Feeds); err != nil {
+		return core.AttackDetails{}, err
+	}
 	if err := s.distributeStart(operationStart); err != nil {
+		delete(s.pendingFeeds, operationStart.AttackID)
 		return core.AttackDetails{}, err
 	}
 
@@ -42,26 +104,48 @@ func (s *attackService) StartAttack(start core.StartAttack) (core.AttackDetails,
 	s.incrementSeqs[operationStart.AttackID]++
 
 	incrementDetails := core.IncrementDetails{
-		ID:        operationStart.IncrementID,
-		AttackID:  operationStart.AttackID,
-		Scenarios: operationStart.Scenarios,
+		ID:                operationStart.IncrementID,
+		AttackID:          operationStart.AttackID,
+		Scenarios:         operationStart.Scenarios,
+		ArrivalRates:      operationStart.ArrivalRates,
+		Pacings:           operationStart.Pacings,
+		Iterations:        operationStart.Iterations,
+		IterationsPerUser: operationStart.IterationsPerUser,
 	}
 	increments := []core.IncrementDetails{incrementDetails}
 
 	createdAt := time.Now().UTC().Truncate(time.Second)
 	attackDetails := core.AttackDetails{
-		ID:           operationStart.AttackID,
-		Name:         start.Name,
-		WaitTimeSec:  start.WaitTimeSec,
-		CreatedAt:    createdAt,
-		DurationSec:  start.DurationSec,
-		ConstConfig:  start.ConstConfig,
-		LinearConfig: start.LinearConfig,
-		Increments:   increments,
+		ID:                operationStart.AttackID,
+		Name:              start.Name,
+		WaitTimeSec:       start.WaitTimeSec,
+		CreatedAt:         createdAt,
+		DurationSec:       start.DurationSec,
+		ConstConfig:       start.ConstConfig,
+		LinearConfig:      start.LinearConfig,
+		ArrivalRateConfig: start.ArrivalRateConfig,
+		StagesConfig:      start.StagesConfig,
+		SpikeConfig:       start.SpikeConfig,
+		PeriodicConfig:    start.PeriodicConfig,
+		TraceConfig:       start.TraceConfig,
+		AdaptiveConfig:    start.AdaptiveConfig,
+		ScheduledRun:      start.ScheduledRun,
+		Template:          start.Template,
+		Iterations:        start.Iterations,
+		IterationsPerUser: start.IterationsPerUser,
+		Target:            target,
+		Params:            params,
+		Feeds:             start.Feeds,
+		Increments:        increments,
 	}
 	attack := attack{
-		details: attackDetails,
-		stopBr:  broadcast.NewBroadcaster[any](),
+		details:     attackDetails,
+		stopBr:      broadcast.NewBroadcaster[any](),
+		pause:       newPauseGate(),
+		completions: make(map[int64]map[string]nodeCompletion),
+		setups:      make(map[string]*setup),
+		// A load profile dropping to zero holds the attack without users until its load comes back
+		holdsEmpty: start.StagesConfig != nil || (start.TraceConfig != nil && start.TraceConfig.Mode != core.LoadRPS),
 	}
 	s.attacks[operationStart.AttackID] = attack
 
@@ -71,6 +155,24 @@ func (s *attackService) StartAttack(start core.StartAttack) (core.AttackDetails,
 	}
 	if start.LinearConfig != nil {
 		go s.handleLinear(attack)
+		if start.LinearConfig.RampDownSec != nil {
+			go s.handleRampDown(attack)
+		}
+	}
+	if start.StagesConfig != nil {
+		go s.handleStages(attack)
+	}
+	if start.SpikeConfig != nil {
+		go s.handleSpikes(attack)
+	}
+	if start.PeriodicConfig != nil {
+		go s.handlePeriodic(attack)
+	}
+	if start.TraceConfig != nil {
+		go s.handleTrace(attack)
+	}
+	if start.AdaptiveConfig != nil {
+		go s.handleAdaptive(attack)
 	}
 
 	return attackDetails, nil
@@ -80,11 +182,14 @@ func (s *attackService) StartAttack(start core.StartAttack) (core.AttackDetails,
 //
 // Parameters:
 //   - start: Configuration for the new increment
+//   - ttlSec: Time to live of the increment in seconds, nil if it runs until stopped
 //
 // Returns:
 //   - core.IncrementDetails: Details of the created increment
 //   - error: Possible errors:
 //   - core.ErrAttackNotFound if specified attack doesn't exist
+//   - core.ErrAttackBounded if the attack runs a fixed total of iterations
+//   - core.ErrBadParams if a new scenario has a required parameter without a default
 //   - Errors from operation distribution
 //
 // The method:
@@ -92,19 +197,52 @@ func (s *attackService) StartAttack(start core.StartAttack) (core.AttackDetails,
 // 2. Generates a new increment ID
 // 3. Distributes scenarios to available nodes
 // 4. Updates attack details with new increment
-func (s *attackService) StartIncrement(start core.OperationStart) (core.IncrementDetails, error) {
+// 5. Plans the stop of the increment once its time to live elapses
+func (s *attackService) StartIncrement(start core.OperationStart, ttlSec *int64) (core.IncrementDetails, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	incrementDetails, err := s.startIncrement(start)
+	if err != nil || ttlSec == nil {
+		return incrementDetails, err
+	}
+
+	return s.limitIncrement(incrementDetails, *ttlSec), nil
+}
+
+// startIncrement is the internal implementation of increment starting.
+//
+// Parameters:
+//   - start: Configuration for the new increment
+//
+// Returns:
+//   - core.IncrementDetails: Details of the created increment
+//   - error: Possible errors (same as StartIncrement)
+func (s *attackService) startIncrement(start core.OperationStart) (core.IncrementDetails, error) {
 	attack, exists := s.attacks[start.AttackID]
 	if !exists {
 		return core.IncrementDetails{}, core.ErrAttackNotFound
 	}
 
+	// The total of iterations is split across the nodes at start, so it cannot take more users
+	if attack.details.Iterations != nil {
+		return core.IncrementDetails{}, core.ErrAttackBounded
+	}
+
 	// Set increment parameters from parent attack
 	start.AttackID = attack.details.ID
 	start.IncrementID = s.incrementSeqs[attack.details.ID]
 	start.WaitTimeSec = attack.details.WaitTimeSec
+	start.IterationsPerUser = 0
+	if attack.details.IterationsPerUser != nil {
+		start.IterationsPerUser = *attack.details.IterationsPerUser
+	}
+	start.Pacings = scenarioPacings(attack.details.ConstConfig, attack.details.LinearConfig)
+	start.Target = attack.details.Target
+	if err := s.extendParams(&attack, start.Scenarios); err != nil {
+		return core.IncrementDetails{}, err
+	}
+	start.Params = attack.details.Params
 
 	if err := s.distributeStart(start); err != nil {
 		return core.IncrementDetails{}, err
@@ -114,9 +252,12 @@ func (s *attackService) StartIncrement(start core.OperationStart) (core.Incremen
 
 	// Create and store increment details
 	incrementDetails := core.IncrementDetails{
-		ID:        start.IncrementID,
-		AttackID:  start.AttackID,
-		Scenarios: start.Scenarios,
+		ID:                start.IncrementID,
+		AttackID:          start.AttackID,
+		Scenarios:         start.Scenarios,
+		ArrivalRates:      start.ArrivalRates,
+		Pacings:           start.Pacings,
+		IterationsPerUser: start.IterationsPerUser,
 	}
 	attack.details.Increments = append(attack.details.Increments, incrementDetails)
 	s.attacks[start.AttackID] = attack
@@ -134,12 +275,22 @@ func (s *attackService) StartIncrement(start core.OperationStart) (core.Incremen
 //
 // The method:
 // 1. Validates all scenarios exist in the system
-// 2. Removes scenarios with zero or negative amounts
+// 2. Removes scenarios with zero or negative amounts, along with their arrival rates and pacing
 // 3. Divides the workload across nodes that support each scenario
 func (s *attackService) distributeStart(start core.OperationStart) error {
 	if err := s.validateScenarios(start.Scenarios); err != nil {
 		return err
 	}
+	for scenario := range start.ArrivalRates {
+		if _, exists := start.Scenarios[scenario]; !exists {
+			delete(start.ArrivalRates, scenario)
+		}
+	}
+	for scenario := range start.Pacings {
+		if _, exists := start.Scenarios[scenario]; !exists {
+			delete(start.Pacings, scenario)
+		}
+	}
 
 	s.divideTasks(start)
 	return nil
@@ -179,16 +330,25 @@ func (s *attackService) validateScenarios(scenarios map[string]int64) error {
 // 1. Creates operation structures for each node
 // 2. Evenly splits scenario amounts across nodes that support them
 // 3. Handles remainder distribution for uneven splits
-// 4. Starts the operations on each node
+// 4. Splits arrival rates in proportion to the users pool each node received
+// 5. Passes the pacing of the scenarios each node received
+// 6. Splits the iteration budget in proportion to the users each node received
+// 7. Attaches the dataset rows of the nodes
+// 8. Starts the operations on each node
 func (s *attackService) divideTasks(start core.OperationStart) {
 	operations := make(map[string]core.OperationStart)
 	for node := range s.nodes {
 		operations[node] = core.OperationStart{
-			ID:          uuid.NewString(),
-			AttackID:    start.AttackID,
-			IncrementID: start.IncrementID,
-			WaitTimeSec: start.WaitTimeSec,
-			Scenarios:   make(map[string]int64),
+			ID:                uuid.NewString(),
+			AttackID:          start.AttackID,
+			IncrementID:       start.IncrementID,
+			WaitTimeSec:       start.WaitTimeSec,
+			Scenarios:         make(map[string]int64),
+			ArrivalRates:      make(map[string]core.ArrivalRate),
+			Pacings:           make(map[string]core.Pacing),
+			IterationsPerUser: start.IterationsPerUser,
+			Target:            start.Target,
+			Params:            start.Params,
 		}
 	}
 
@@ -219,29 +379,57 @@ func (s *attackService) divideTasks(start core.OperationStart) {
 
 			if resultAmount != 0 {
 				operations[node].Scenarios[scenario] = resultAmount
+				if pacing, exists := start.Pacings[scenario]; exists {
+					operations[node].Pacings[scenario] = pacing
+				}
+
+				// Each node gets the share of the rate its users pool can serve
+				if rate, exists := start.ArrivalRates[scenario]; exists {
+					share := float64(resultAmount) / float64(amount)
+					operations[node].ArrivalRates[scenario] = core.ArrivalRate{
+						Rate:       rate.Rate * share,
+						TargetRate: rate.TargetRate * share,
+						RampUpSec:  rate.RampUpSec,
+					}
+				}
 			}
 		}
 	}
 
-	// Start operations on each node
+	if start.Iterations != nil {
+		divideIterations(*start.Iterations, operations)
+	}
+	s.divideFeeds(start, operations)
+
+	// Start operations on each node, new users of a paused attack are paused as well
+	paused := s.attacks[start.AttackID].details.Paused
 	for nodeName, operation := range operations {
 		if len(operation.Scenarios) != 0 {
 			if err := s.nodes[nodeName].StartAttack(operation); err != nil {
 				log.Printf("impossible to start attack on node %s: %v", nodeName, err)
+				continue
+			}
+			s.reopenIncrement(start.AttackID, start.IncrementID, nodeName)
+			if paused {
+				if err := s.nodes[nodeName].PauseAttack(core.OperationPause{AttackID: start.AttackID}); err != nil {
+					log.Printf("impossible to pause attack on node %s: %v", nodeName, err)
+				}
 			}
 		}
 	}
 }
 
 // StopAttack terminates an entire attack and all its increments.
+// The attack ends right away, a graceful stop only lets the nodes finish the running iterations.
 //
 // Parameters:
 //   - attackID: ID of the attack to stop
+//   - options: The way the running iterations are stopped
 //
 // Returns:
 //   - error: Possible errors:
 //   - core.ErrAttackNotFound if attack doesn't exist
-func (s *attackService) StopAttack(attackID int64) error {
+func (s *attackService) StopAttack(attackID int64, options core.StopOptions) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -251,12 +439,12 @@ func (s *attackService) StopAttack(attackID int64) error {
 	}
 
 	operation := core.OperationStop{
-		AttackID: attackID,
+		AttackID:        attackID,
+		DrainTimeoutSec: drainTimeout(options),
 	}
 	s.distributeStop(operation)
 
-	attack.stopBr.Broadcast(nil)
-	delete(s.attacks, attackID)
+	s.endAttack(attack)
 
 	return nil
 }
@@ -266,16 +454,17 @@ func (s *attackService) StopAttack(attackID int64) error {
 // Parameters:
 //   - attackID: ID of the parent attack
 //   - incrementID: ID of the increment to stop
+//   - options: The way the running iterations are stopped
 //
 // Returns:
 //   - error: Possible errors:
 //   - core.ErrAttackNotFound if attack doesn't exist
 //   - core.ErrIncrementNotFound if increment doesn't exist
-func (s *attackService) StopIncrement(attackID, incrementID int64) error {
+func (s *attackService) StopIncrement(attackID, incrementID int64, options core.StopOptions) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.stopIncrement(attackID, incrementID)
+	return s.stopIncrement(attackID, incrementID, options)
 }
 
 // stopIncrement is the internal implementation of increment stopping.
@@ -283,15 +472,16 @@ func (s *attackService) StopIncrement(attackID, incrementID int64) error {
 // Parameters:
 //   - attackID: ID of the parent attack
 //   - incrementID: ID of the increment to stop
+//   - options: The way the running iterations are stopped
 //
 // Returns:
 //   - error: Possible errors (same as StopIncrement)
 //
 // The method:
 // 1. Validates attack and increment existence
-// 2. Distributes stop commands to all nodes
-// 3. Updates attack details or removes attack if last increment
-func (s *attackService) stopIncrement(attackID, incrementID int64) error {
+// 2. Distributes stop commands to all nodes, of the whole attack if last increment
+// 3. Updates attack details or ends the attack if last increment, unless the attack holds zero load
+func (s *attackService) stopIncrement(attackID, incrementID int64, options core.StopOptions) error {
 	attack, exists := s.attacks[attackID]
 	if !exists {
 		return core.ErrAttackNotFound
@@ -307,24 +497,265 @@ func (s *attackService) stopIncrement(attackID, incrementID int64) error {
 		return core.ErrIncrementNotFound
 	}
 
-	// Distribute stop command
 	operation := core.OperationStop{
-		AttackID:    attackID,
-		IncrementID: &incrementID,
+		AttackID:        attackID,
+		DrainTimeoutSec: drainTimeout(options),
 	}
-	s.distributeStop(operation)
 
-	// Update or remove attack
-	if len(attack.details.Increments) > 1 {
+	// Update or remove attack, the last increment stops the whole attack so that the nodes tear it down
+	if len(attack.details.Increments) > 1 || attack.holdsEmpty {
+		operation.IncrementID = &incrementID
+		s.distributeStop(operation)
+
 		attack.details.Increments = slices.Delete(attack.details.Increments, i, i+1)
+		delete(attack.completions, incrementID)
 		s.attacks[attackID] = attack
 	} else {
-		delete(s.attacks, attackID)
+		s.distributeStop(operation)
+		s.endAttack(attack)
+	}
+
+	return nil
+}
+
+// reduceIncrement retires part of the users of an increment.
+//
+// Parameters:
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment to reduce
+//   - scenarios: Map of scenario names to the amount of users to retire
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - core.ErrIncrementNotFound if increment doesn't exist
+//
+// The method:
+// 1. Validates attack and increment existence
+// 2. Stops the whole increment if no users would remain
+// 3. Distributes reduce commands to the nodes holding the increment
+// 4. Updates the increment counters
+func (s *attackService) reduceIncrement(attackID, incrementID int64, scenarios map[string]int64) error {
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	i := slices.IndexFunc(attack.details.Increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == incrementID
+	})
+	if i == -1 {
+		return core.ErrIncrementNotFound
 	}
 
+	// Compute the remaining counters
+	remaining := make(map[string]int64)
+	for scenario, counter := range attack.details.Increments[i].Scenarios {
+		if left := counter - scenarios[scenario]; left > 0 {
+			remaining[scenario] = left
+		}
+	}
+	if len(remaining) == 0 {
+		return s.stopIncrement(attackID, incrementID, core.StopOptions{})
+	}
+
+	// Distribute reduce command
+	operation := core.OperationReduce{
+		AttackID:    attackID,
+		IncrementID: incrementID,
+		Scenarios:   scenarios,
+	}
+	s.distributeReduce(operation)
+
+	// Update increment details
+	increments := slices.Clone(attack.details.Increments)
+	increments[i].Scenarios = remaining
+	attack.details.Increments = increments
+	s.attacks[attackID] = attack
+
+	return nil
+}
+
+// rescaleIncrement changes the arrival rates of the open model scenarios of an increment in place.
+// The users pools of the increment keep running, along with the state of their users.
+//
+// Parameters:
+//   - attackID: ID of the attack to rescale
+//   - incrementID: ID of the increment to rescale
+//   - rates: Map of scenario names to their new arrival rates
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - core.ErrIncrementNotFound if increment doesn't exist
+//
+// Scenarios the increment does not serve at an arrival rate are ignored.
+//
+// Must be called with s.mu held.
+func (s *attackService) rescaleIncrement(attackID, incrementID int64, rates map[string]core.ArrivalRate) error {
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	i := slices.IndexFunc(attack.details.Increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == incrementID
+	})
+	if i == -1 {
+		return core.ErrIncrementNotFound
+	}
+
+	arrivalRates := maps.Clone(attack.details.Increments[i].ArrivalRates)
+	for scenario, rate := range rates {
+		if _, exists := arrivalRates[scenario]; exists {
+			arrivalRates[scenario] = rate
+		}
+	}
+
+	// Distribute rescale command
+	s.distributeRescale(core.OperationRescale{
+		AttackID:     attackID,
+		IncrementID:  incrementID,
+		ArrivalRates: arrivalRates,
+	})
+
+	// Update increment details
+	increments := slices.Clone(attack.details.Increments)
+	increments[i].ArrivalRates = arrivalRates
+	attack.details.Increments = increments
+	s.attacks[attackID] = attack
+
 	return nil
 }
 
+// distributeRescale splits the arrival rates across the nodes holding the increment.
+//
+// Parameters:
+//   - rescale: Operation rescale details to distribute
+//
+// Each node gets the share of the rates its users pool can serve, as when the increment was started.
+// The method handles logging of any node-specific rescale failures.
+func (s *attackService) distributeRescale(rescale core.OperationRescale) {
+	// Find the users pools of the increment held by each node
+	holdings := make(map[string]map[string]int64)
+	totals := make(map[string]int64)
+	for nodeName, node := range s.nodes {
+		for _, attack := range node.GetDetails().Attacks {
+			if attack.ID != rescale.AttackID {
+				continue
+			}
+			for _, increment := range attack.Increments {
+				if increment.ID == rescale.IncrementID {
+					holdings[nodeName] = increment.Scenarios
+					for scenario, counter := range increment.Scenarios {
+						totals[scenario] += counter
+					}
+				}
+			}
+		}
+	}
+
+	// Rescale the increment on each node
+	for nodeName, scenarios := range holdings {
+		operation := core.OperationRescale{
+			AttackID:     rescale.AttackID,
+			IncrementID:  rescale.IncrementID,
+			ArrivalRates: make(map[string]core.ArrivalRate),
+		}
+		for scenario, rate := range rescale.ArrivalRates {
+			if scenarios[scenario] == 0 {
+				continue
+			}
+			share := float64(scenarios[scenario]) / float64(totals[scenario])
+			operation.ArrivalRates[scenario] = core.ArrivalRate{
+				Rate:       rate.Rate * share,
+				TargetRate: rate.TargetRate * share,
+				RampUpSec:  rate.RampUpSec,
+			}
+		}
+
+		if len(operation.ArrivalRates) != 0 {
+			if err := s.nodes[nodeName].RescaleAttack(operation); err != nil {
+				log.Printf("impossible to rescale attack on node %s: %v", nodeName, err)
+			}
+		}
+	}
+}
+
+// distributeReduce splits the users to retire across the nodes holding the increment.
+//
+// Parameters:
+//   - reduce: Operation reduce details to distribute
+//
+// The users are taken from the most loaded nodes first, so that the remaining load
+// stays evenly spread. The method handles logging of any node-specific reduce failures.
+func (s *attackService) distributeReduce(reduce core.OperationReduce) {
+	// Find the users of the increment held by each node
+	holdings := make(map[string]map[string]int64)
+	for nodeName, node := range s.nodes {
+		for _, attack := range node.GetDetails().Attacks {
+			if attack.ID != reduce.AttackID {
+				continue
+			}
+			for _, increment := range attack.Increments {
+				if increment.ID == reduce.IncrementID {
+					holdings[nodeName] = increment.Scenarios
+				}
+			}
+		}
+	}
+
+	operations := make(map[string]core.OperationReduce)
+	for nodeName := range holdings {
+		operations[nodeName] = core.OperationReduce{
+			AttackID:    reduce.AttackID,
+			IncrementID: reduce.IncrementID,
+			Scenarios:   make(map[string]int64),
+		}
+	}
+
+	nodeNames := slices.Sorted(maps.Keys(holdings))
+	for scenario, amount := range reduce.Scenarios {
+		// Lower the most loaded nodes to a common level
+		loads := make([]int64, len(nodeNames))
+		var total int64
+		for i, nodeName := range nodeNames {
+			loads[i] = -holdings[nodeName][scenario]
+			total += holdings[nodeName][scenario]
+		}
+		for i, share := range levelShares(loads, min(amount, total)) {
+			if share != 0 {
+				operations[nodeNames[i]].Scenarios[scenario] = share
+			}
+		}
+	}
+
+	// Reduce the increment on each node
+	for nodeName, operation := range operations {
+		if len(operation.Scenarios) != 0 {
+			if err := s.nodes[nodeName].ReduceAttack(operation); err != nil {
+				log.Printf("impossible to reduce attack on node %s: %v", nodeName, err)
+			}
+		}
+	}
+}
+
+// drainTimeout computes the drain timeout of the stop operations.
+//
+// Parameters:
+//   - options: The way the running iterations are stopped
+//
+// Returns:
+//   - *int64: Time the running iterations are given to finish (in seconds), nil for an immediate stop
+func drainTimeout(options core.StopOptions) *int64 {
+	if options.Mode != core.StopGraceful {
+		return nil
+	}
+
+	timeout := options.DrainTimeoutSec
+	return &timeout
+}
+
 // distributeStop sends stop commands to all nodes for an operation.
 //
 // Parameters:
@@ -0,0 +1,93 @@
+package attack
+
+import (
+	"fmt"
+	"load-generation-system/internal/core"
+	"load-generation-system/internal/scenarios"
+	"maps"
+)
+
+// resolveParams checks the parameters of the scenarios of an attack against the schemas advertised
+// by the nodes and applies the defaults, so that invalid parameters are refused before any node starts the attack.
+//
+// Parameters:
+//   - params: Parameters given per scenario name, may be nil
+//   - attackScenarios: Scenarios run by the attack
+//
+// Returns:
+//   - map[string]core.Params: Resolved parameters of the attack scenarios that have some
+//   - error: Possible errors:
+//   - core.ErrScenarioNotFound if parameters are given to an unknown scenario
+//   - core.ErrBadParams if parameters are given to a scenario the attack does not run, or do not match its schema
+//
+// Must be called with s.mu held.
+func (s *attackService) resolveParams(
+	params map[string]core.Params,
+	attackScenarios map[string]int64,
+) (map[string]core.Params, error) {
+	uniqueScenarios := s.getScenarios()
+	for scenario := range params {
+		if _, exists := uniqueScenarios[scenario]; !exists {
+			return nil, core.ErrScenarioNotFound
+		}
+		if _, exists := attackScenarios[scenario]; !exists {
+			return nil, fmt.Errorf("%w: the attack does not run scenario %s", core.ErrBadParams, scenario)
+		}
+	}
+
+	resolved := make(map[string]core.Params)
+	for scenario := range attackScenarios {
+		scenarioDetails, exists := uniqueScenarios[scenario]
+		if !exists {
+			// Reported by the scenario validation of the distribution
+			continue
+		}
+
+		scenarioParams, err := scenarios.ResolveParams(scenarioDetails.Parameters, params[scenario])
+		if err != nil {
+			return nil, fmt.Errorf("scenario %s: %w", scenario, err)
+		}
+		if len(scenarioParams) != 0 {
+			resolved[scenario] = scenarioParams
+		}
+	}
+
+	return resolved, nil
+}
+
+// extendParams resolves the parameters of the scenarios an attack starts to run after its start,
+// through a new increment or a scaling. They run with the defaults of their parameters.
+//
+// Parameters:
+//   - attack: The attack receiving the scenarios, updated in place
+//   - scenarios: Scenarios added to the attack
+//
+// Returns:
+//   - error: core.ErrBadParams if a new scenario has a required parameter without a default
+//
+// Must be called with s.mu held.
+func (s *attackService) extendParams(attack *attack, scenarios map[string]int64) error {
+	added := make(map[string]int64)
+	for scenario, amount := range scenarios {
+		if _, exists := attack.details.Params[scenario]; !exists && amount > 0 {
+			added[scenario] = amount
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	resolved, err := s.resolveParams(nil, added)
+	if err != nil || len(resolved) == 0 {
+		return err
+	}
+
+	params := maps.Clone(attack.details.Params)
+	if params == nil {
+		params = make(map[string]core.Params, len(resolved))
+	}
+	maps.Copy(params, resolved)
+	attack.details.Params = params
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package attack
+
+import (
+	"errors"
+	"load-generation-system/internal/core"
+	"log"
+	"sync"
+	"time"
+)
+
+// pauseGate shares the paused state of an attack with its handlers, so they can freeze their timers.
+type pauseGate struct {
+	paused  bool       // Whether the attack is paused
+	changed chan any   // Channel closed and replaced on every state change
+	mu      sync.Mutex // Mutex to protect the state
+}
+
+func newPauseGate() *pauseGate {
+	return &pauseGate{
+		changed: make(chan any),
+	}
+}
+
+// set switches the paused state and wakes up the handlers waiting for a change.
+//
+// Parameters:
+//   - paused: The new state
+//
+// Returns:
+//   - bool: false if the gate is already in the given state
+func (g *pauseGate) set(paused bool) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.paused == paused {
+		return false
+	}
+
+	g.paused = paused
+	close(g.changed)
+	g.changed = make(chan any)
+
+	return true
+}
+
+// state returns the current paused state along with the channel signalling its next change.
+//
+// Returns:
+//   - bool: Whether the attack is paused
+//   - chan any: Channel closed on the next state change
+func (g *pauseGate) state() (bool, chan any) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.paused, g.changed
+}
+
+// PauseAttack suspends an attack on every node without destroying its users.
+// The attack handlers freeze their timers until the attack is resumed.
+//
+// Parameters:
+//   - attackID: ID of the attack to pause
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - core.ErrAttackPaused if attack is already paused
+func (s *attackService) PauseAttack(attackID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	if !attack.pause.set(true) {
+		return core.ErrAttackPaused
+	}
+
+	s.distributePause(core.OperationPause{
+		AttackID: attackID,
+	})
+
+	pausedAt := time.Now()
+	attack.details.Paused = true
+	attack.details.PausedAt = &pausedAt
+	s.attacks[attackID] = attack
+
+	return nil
+}
+
+// ResumeAttack continues a paused attack on every node.
+//
+// Parameters:
+//   - attackID: ID of the attack to resume
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - core.ErrAttackNotPaused if attack is not paused
+func (s *attackService) ResumeAttack(attackID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	if !attack.pause.set(false) {
+		return core.ErrAttackNotPaused
+	}
+
+	s.distributeResume(core.OperationResume{
+		AttackID: attackID,
+	})
+
+	postponeExpiries(&attack)
+	attack.details.Paused = false
+	attack.details.PausedAt = nil
+	s.attacks[attackID] = attack
+
+	return nil
+}
+
+// distributePause sends pause commands to all nodes running the attack.
+//
+// Parameters:
+//   - pause: Operation pause details to distribute
+//
+// Nodes not running the attack are skipped.
+func (s *attackService) distributePause(pause core.OperationPause) {
+	for nodeName, node := range s.nodes {
+		if err := node.PauseAttack(pause); err != nil && !errors.Is(err, core.ErrAttackNotFound) {
+			log.Printf("impossible to pause attack on node %s: %v", nodeName, err)
+		}
+	}
+}
+
+// distributeResume sends resume commands to all nodes running the attack.
+//
+// Parameters:
+//   - resume: Operation resume details to distribute
+//
+// Nodes not running the attack are skipped.
+func (s *attackService) distributeResume(resume core.OperationResume) {
+	for nodeName, node := range s.nodes {
+		if err := node.ResumeAttack(resume); err != nil && !errors.Is(err, core.ErrAttackNotFound) {
+			log.Printf("impossible to resume attack on node %s: %v", nodeName, err)
+		}
+	}
+}
+
+// sleep blocks for the given duration unless the attack is stopped earlier.
+// Time spent while the attack is paused does not count towards the duration.
+//
+// Parameters:
+//   - stop: Subscription to the attack stop broadcaster
+//   - pause: The pause gate of the attack
+//   - duration: Time to wait
+//
+// Returns:
+//   - bool: false if the attack was stopped while waiting
+func (s *attackService) sleep(stop chan any, pause *pauseGate, duration time.Duration) bool {
+	for {
+		paused, changed := pause.state()
+		if paused {
+			select {
+			case <-changed:
+				continue
+			case <-stop:
+				return false
+			}
+		}
+
+		started := time.Now()
+		timer := time.NewTimer(duration)
+		select {
+		case <-timer.C:
+			return true
+		case <-changed:
+			// Paused - keep the rest of the duration for later
+			timer.Stop()
+			duration -= time.Since(started)
+		case <-stop:
+			timer.Stop()
+			return false
+		}
+	}
+}
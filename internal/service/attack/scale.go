@@ -0,0 +1,467 @@
+package attack
+
+import (
+	"cmp"
+	"load-generation-system/internal/core"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// ScaleAttack sets new total counters of the attack scenarios without restarting its users.
+// Scenarios missing from the map keep their current load.
+//
+// Parameters:
+//   - attackID: ID of the attack to scale
+//   - scenarios: Map of scenario names to the desired total counters
+//
+// Returns:
+//   - core.AttackDetails: Details of the scaled attack
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - core.ErrAttackBounded if the attack runs a fixed total of iterations
+//   - core.ErrScenarioNotFound if invalid scenarios specified
+//   - core.ErrBadConfig if any counter is negative
+//   - core.ErrBadParams if a new scenario has a required parameter without a default
+//   - core.ErrEmptyAttack if no users would remain
+//
+// The method:
+// 1. Adds the missing users to the newest increment running the scenario, or to a new increment if there is none
+// 2. Retires the excess users starting from the newest increments
+func (s *attackService) ScaleAttack(attackID int64, scenarios map[string]int64) (core.AttackDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.AttackDetails{}, core.ErrAttackNotFound
+	}
+	if attack.details.Iterations != nil {
+		return core.AttackDetails{}, core.ErrAttackBounded
+	}
+
+	current := s.currentLoad(attack)
+	if err := s.validateScale(current, scenarios); err != nil {
+		return core.AttackDetails{}, err
+	}
+	if err := s.extendParams(&attack, scenarios); err != nil {
+		return core.AttackDetails{}, err
+	}
+	s.attacks[attackID] = attack
+
+	// Group the missing users by the increments receiving them,
+	// an attack held without increments receives them with a new one
+	missing := make(map[int64]map[string]int64)
+	fresh := make(map[string]int64)
+	excess := make(map[string]int64)
+	for scenario, counter := range scenarios {
+		switch {
+		case counter > current[scenario] && len(attack.details.Increments) == 0:
+			fresh[scenario] = counter
+		case counter > current[scenario]:
+			incrementID := newestIncrement(attack.details.Increments, scenario)
+			if missing[incrementID] == nil {
+				missing[incrementID] = make(map[string]int64)
+			}
+			missing[incrementID][scenario] = counter - current[scenario]
+		case counter < current[scenario]:
+			excess[scenario] = current[scenario] - counter
+		}
+	}
+
+	for incrementID, added := range missing {
+		s.growIncrement(attackID, incrementID, added)
+	}
+	if len(fresh) != 0 {
+		if _, err := s.startIncrement(core.OperationStart{AttackID: attackID, Scenarios: fresh}); err != nil {
+			return core.AttackDetails{}, err
+		}
+	}
+
+	if len(excess) != 0 {
+		if err := s.retireLoad(attackID, excess); err != nil {
+			return core.AttackDetails{}, err
+		}
+	}
+
+	return s.attacks[attackID].details, nil
+}
+
+// ScaleIncrement sets new counters of the increment scenarios without restarting its users.
+// Scenarios missing from the map keep their current counters.
+//
+// Parameters:
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment to scale
+//   - scenarios: Map of scenario names to the desired counters
+//
+// Returns:
+//   - core.IncrementDetails: Details of the scaled increment
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+//   - core.ErrIncrementNotFound if increment doesn't exist
+//   - core.ErrAttackBounded if the attack runs a fixed total of iterations
+//   - core.ErrScenarioNotFound if invalid scenarios specified
+//   - core.ErrBadConfig if any counter is negative
+//   - core.ErrBadParams if a new scenario has a required parameter without a default
+//   - core.ErrEmptyAttack if no users would remain in the increment
+func (s *attackService) ScaleIncrement(attackID, incrementID int64, scenarios map[string]int64) (core.IncrementDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.IncrementDetails{}, core.ErrAttackNotFound
+	}
+	if attack.details.Iterations != nil {
+		return core.IncrementDetails{}, core.ErrAttackBounded
+	}
+
+	i := slices.IndexFunc(attack.details.Increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == incrementID
+	})
+	if i == -1 {
+		return core.IncrementDetails{}, core.ErrIncrementNotFound
+	}
+
+	current := attack.details.Increments[i].Scenarios
+	if err := s.validateScale(current, scenarios); err != nil {
+		return core.IncrementDetails{}, err
+	}
+	if err := s.extendParams(&attack, scenarios); err != nil {
+		return core.IncrementDetails{}, err
+	}
+	s.attacks[attackID] = attack
+
+	added := make(map[string]int64)
+	retired := make(map[string]int64)
+	for scenario, counter := range scenarios {
+		switch {
+		case counter > current[scenario]:
+			added[scenario] = counter - current[scenario]
+		case counter < current[scenario]:
+			retired[scenario] = current[scenario] - counter
+		}
+	}
+
+	if len(added) != 0 {
+		s.growIncrement(attackID, incrementID, added)
+	}
+	if len(retired) != 0 {
+		if err := s.reduceIncrement(attackID, incrementID, retired); err != nil {
+			return core.IncrementDetails{}, err
+		}
+	}
+
+	increments := s.attacks[attackID].details.Increments
+	return increments[slices.IndexFunc(increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == incrementID
+	})], nil
+}
+
+// validateScale checks the new counters of a scaling request.
+//
+// Parameters:
+//   - current: Map of scenario names to the current counters
+//   - scenarios: Map of scenario names to the desired counters
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrScenarioNotFound if any scenario doesn't exist
+//   - core.ErrBadConfig if any counter is negative
+//   - core.ErrEmptyAttack if no users would remain
+func (s *attackService) validateScale(current, scenarios map[string]int64) error {
+	uniqueScenarios := s.getScenarios()
+
+	var total int64
+	for scenario, counter := range current {
+		if _, exists := scenarios[scenario]; !exists {
+			total += counter
+		}
+	}
+	for scenario, counter := range scenarios {
+		if _, exists := uniqueScenarios[scenario]; !exists {
+			return core.ErrScenarioNotFound
+		}
+		if counter < 0 {
+			return core.ErrBadConfig
+		}
+		total += counter
+	}
+
+	if total == 0 {
+		return core.ErrEmptyAttack
+	}
+
+	return nil
+}
+
+// newestIncrement finds the increment receiving new users of a scenario.
+//
+// Parameters:
+//   - increments: Increments of the attack
+//   - scenario: Name of the scenario
+//
+// Returns:
+//   - int64: ID of the newest increment running the scenario, or of the newest increment if none does
+func newestIncrement(increments []core.IncrementDetails, scenario string) int64 {
+	var newest, newestRunning *core.IncrementDetails
+	for i := range increments {
+		if newest == nil || increments[i].ID > newest.ID {
+			newest = &increments[i]
+		}
+		if _, exists := increments[i].Scenarios[scenario]; exists {
+			if newestRunning == nil || increments[i].ID > newestRunning.ID {
+				newestRunning = &increments[i]
+			}
+		}
+	}
+
+	if newestRunning != nil {
+		return newestRunning.ID
+	}
+	return newest.ID
+}
+
+// growIncrement adds users to an existing increment.
+//
+// Parameters:
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment to grow
+//   - scenarios: Map of scenario names to the amount of users to add
+//
+// The users are split across the nodes by distributeGrow and the increment counters are raised
+// by the users the nodes actually received. Users of open model scenarios no node serves are dropped.
+//
+// Must be called with s.mu held.
+func (s *attackService) growIncrement(attackID, incrementID int64, scenarios map[string]int64) {
+	attack := s.attacks[attackID]
+
+	// New users run as many iterations as the others of an iteration-bounded increment,
+	// and follow the pacing of their scenario
+	var iterationsPerUser int64
+	for _, increment := range attack.details.Increments {
+		if increment.ID == incrementID {
+			iterationsPerUser = increment.IterationsPerUser
+		}
+	}
+	pacings := scenarioPacings(attack.details.ConstConfig, attack.details.LinearConfig)
+	for scenario := range pacings {
+		if _, exists := scenarios[scenario]; !exists {
+			delete(pacings, scenario)
+		}
+	}
+
+	distributed := s.distributeGrow(core.OperationStart{
+		AttackID:          attackID,
+		IncrementID:       incrementID,
+		WaitTimeSec:       attack.details.WaitTimeSec,
+		Scenarios:         scenarios,
+		Pacings:           pacings,
+		IterationsPerUser: iterationsPerUser,
+		Target:            attack.details.Target,
+		Params:            attack.details.Params,
+	})
+
+	increments := slices.Clone(attack.details.Increments)
+	for i := range increments {
+		if increments[i].ID != incrementID {
+			continue
+		}
+
+		counters := make(map[string]int64, len(increments[i].Scenarios))
+		for scenario, counter := range increments[i].Scenarios {
+			counters[scenario] = counter
+		}
+		for scenario, amount := range distributed {
+			counters[scenario] += amount
+		}
+		increments[i].Scenarios = counters
+
+		if len(pacings) != 0 {
+			merged := make(map[string]core.Pacing, len(increments[i].Pacings)+len(pacings))
+			for scenario, pacing := range increments[i].Pacings {
+				merged[scenario] = pacing
+			}
+			for scenario, pacing := range pacings {
+				if distributed[scenario] != 0 {
+					merged[scenario] = pacing
+				}
+			}
+			increments[i].Pacings = merged
+		}
+	}
+	attack.details.Increments = increments
+	s.attacks[attackID] = attack
+}
+
+// distributeGrow splits the users to add across the nodes supporting their scenarios.
+//
+// Parameters:
+//   - start: Operation start holding the increment to grow and the amount of users to add
+//
+// Returns:
+//   - map[string]int64: Map of scenario names to the amount of users the nodes received
+//
+// The users are given to the least loaded nodes first, so that the increment stays evenly spread.
+// Nodes already running the increment add the users to it without restarting the others.
+//
+// Must be called with s.mu held.
+func (s *attackService) distributeGrow(start core.OperationStart) map[string]int64 {
+	// Find the users of the increment held by each node
+	holdings := make(map[string]map[string]int64)
+	running := make(map[string]bool)
+	operations := make(map[string]core.OperationStart)
+	for nodeName, node := range s.nodes {
+		holdings[nodeName] = make(map[string]int64)
+		for _, attack := range node.GetDetails().Attacks {
+			if attack.ID != start.AttackID {
+				continue
+			}
+			running[nodeName] = true
+			for _, increment := range attack.Increments {
+				if increment.ID == start.IncrementID {
+					holdings[nodeName] = increment.Scenarios
+				}
+			}
+		}
+
+		operations[nodeName] = core.OperationStart{
+			ID:                uuid.NewString(),
+			AttackID:          start.AttackID,
+			IncrementID:       start.IncrementID,
+			WaitTimeSec:       start.WaitTimeSec,
+			Scenarios:         make(map[string]int64),
+			ArrivalRates:      make(map[string]core.ArrivalRate),
+			Pacings:           make(map[string]core.Pacing),
+			IterationsPerUser: start.IterationsPerUser,
+			Target:            start.Target,
+			Params:            start.Params,
+		}
+	}
+
+	// Open model scenarios can only grow the users pools already serving their arrival rate
+	openModel := make(map[string]bool)
+	for _, increment := range s.attacks[start.AttackID].details.Increments {
+		if increment.ID == start.IncrementID {
+			for scenario := range increment.ArrivalRates {
+				openModel[scenario] = true
+			}
+		}
+	}
+
+	for scenario, amount := range start.Scenarios {
+		// Find nodes that support this scenario
+		var actualNodes []string
+		for nodeName, node := range s.nodes {
+			if openModel[scenario] && holdings[nodeName][scenario] == 0 {
+				continue
+			}
+			for _, scenarioDetails := range node.GetDetails().Scenarios {
+				if scenarioDetails.Name == scenario {
+					actualNodes = append(actualNodes, nodeName)
+					break
+				}
+			}
+		}
+		if len(actualNodes) == 0 {
+			continue
+		}
+		slices.Sort(actualNodes)
+
+		// Raise the least loaded nodes to a common level
+		loads := make([]int64, len(actualNodes))
+		for i, nodeName := range actualNodes {
+			loads[i] = holdings[nodeName][scenario]
+		}
+		for i, share := range levelShares(loads, amount) {
+			if share != 0 {
+				operations[actualNodes[i]].Scenarios[scenario] = share
+			}
+		}
+		if pacing, exists := start.Pacings[scenario]; exists {
+			for nodeName, operation := range operations {
+				if operation.Scenarios[scenario] != 0 {
+					operations[nodeName].Pacings[scenario] = pacing
+				}
+			}
+		}
+	}
+
+	// Grow the increment on each node, new users of a paused attack are paused as well
+	distributed := make(map[string]int64)
+	paused := s.attacks[start.AttackID].details.Paused
+	for nodeName, operation := range operations {
+		if len(operation.Scenarios) != 0 {
+			if err := s.nodes[nodeName].StartAttack(operation); err != nil {
+				log.Printf("impossible to grow attack on node %s: %v", nodeName, err)
+				continue
+			}
+			for scenario, amount := range operation.Scenarios {
+				distributed[scenario] += amount
+			}
+			s.reopenIncrement(start.AttackID, start.IncrementID, nodeName)
+			if paused && !running[nodeName] {
+				if err := s.nodes[nodeName].PauseAttack(core.OperationPause{AttackID: start.AttackID}); err != nil {
+					log.Printf("impossible to pause attack on node %s: %v", nodeName, err)
+				}
+			}
+		}
+	}
+
+	return distributed
+}
+
+// levelShares splits an amount of users between the nodes, raising the least loaded ones to a common level.
+//
+// Parameters:
+//   - loads: Current users of each node
+//   - amount: Amount of users to split
+//
+// Returns:
+//   - []int64: Users given to each node, in the order of the loads
+//
+// Nodes of equal load get the remainder in the order of the loads. Retiring users from the most loaded
+// nodes first is the same split over the negated loads, the amount not exceeding their total.
+func levelShares(loads []int64, amount int64) []int64 {
+	if len(loads) == 0 {
+		return nil
+	}
+
+	order := make([]int, len(loads))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(loads[a], loads[b])
+	})
+
+	// Find the least loaded nodes the amount raises above the load of the next one
+	var sum int64
+	levelled := 0
+	for levelled < len(order) {
+		sum += loads[order[levelled]]
+		levelled++
+		if levelled == len(order) || loads[order[levelled]]*int64(levelled)-sum >= amount {
+			break
+		}
+	}
+
+	level := (sum + amount) / int64(levelled)
+	remainder := (sum + amount) % int64(levelled)
+	if remainder < 0 {
+		level--
+		remainder += int64(levelled)
+	}
+
+	shares := make([]int64, len(loads))
+	for j, i := range order[:levelled] {
+		shares[i] = level - loads[i]
+		if int64(j) < remainder {
+			shares[i]++
+		}
+	}
+
+	return shares
+}
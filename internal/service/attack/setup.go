@@ -0,0 +1,158 @@
+package attack
+
+import (
+	"context"
+	"fmt"
+	"load-generation-system/internal/core"
+	"log"
+)
+
+// setup holds the state of the attack-level setup of a scenario.
+//
+// Fields:
+//   - node: Name of the node running the setup, which also runs the teardown once the attack is over
+//   - done: Closed once the setup is over, or once it is given up along with its node
+//   - finished: Whether the setup is over
+//   - failure: The setup error, empty if the setup succeeded
+type setup struct {
+	node     string
+	done     chan any
+	finished bool
+	failure  string
+}
+
+// ClaimSetup lets the first node claiming the attack-level setup of a scenario run it.
+// The nodes claiming it later wait until it is over, so that their users only start
+// their iterations once the setup has succeeded.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//   - nodeName: Name of the claiming node
+//   - attackID: ID of the attack
+//   - scenario: Name of the scenario
+//
+// Returns:
+//   - bool: true if the node must run the setup, false if another node has run it successfully
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if the attack doesn't exist or ends during the wait
+//   - core.ErrSetupFailed if the setup has failed on another node
+//   - The context error if the context is done before the setup is over
+func (s *attackService) ClaimSetup(ctx context.Context, nodeName string, attackID int64, scenario string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for {
+		attack, exists := s.attacks[attackID]
+		if !exists {
+			return false, core.ErrAttackNotFound
+		}
+
+		current, exists := attack.setups[scenario]
+		if !exists {
+			attack.setups[scenario] = &setup{
+				node: nodeName,
+				done: make(chan any),
+			}
+			return true, nil
+		}
+
+		if current.finished {
+			if current.failure != "" {
+				return false, fmt.Errorf("%w: %s", core.ErrSetupFailed, current.failure)
+			}
+			return false, nil
+		}
+		if current.node == nodeName {
+			// The node has reconnected while running the setup - it runs it again
+			return true, nil
+		}
+
+		// Wait without the lock for the setup to be over
+		done := current.done
+		s.mu.Unlock()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+
+		s.mu.Lock()
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+	}
+}
+
+// CompleteSetup records the outcome of the attack-level setup of a scenario and releases the nodes waiting for it.
+// Outcomes of the setups claimed by another node meanwhile are ignored.
+//
+// Parameters:
+//   - nodeName: Name of the node that has run the setup
+//   - attackID: ID of the attack
+//   - scenario: Name of the scenario
+//   - failure: The setup error, empty if the setup succeeded
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if the attack doesn't exist
+func (s *attackService) CompleteSetup(nodeName string, attackID int64, scenario, failure string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	current, exists := attack.setups[scenario]
+	if !exists || current.node != nodeName || current.finished {
+		return nil
+	}
+
+	if failure != "" {
+		log.Printf("setup of scenario %s for attack %d failed on node %s: %s", scenario, attackID, nodeName, failure)
+	}
+	current.finished = true
+	current.failure = failure
+	close(current.done)
+
+	return nil
+}
+
+// reopenSetups gives up the attack-level setups run by a node that is gone, so that another node claims them.
+// The teardowns of the setups the node has finished are lost along with it.
+//
+// Parameters:
+//   - nodeName: Name of the node
+//
+// Must be called with s.mu held.
+func (s *attackService) reopenSetups(nodeName string) {
+	for attackID, attack := range s.attacks {
+		for scenario, current := range attack.setups {
+			if current.node != nodeName {
+				continue
+			}
+			if current.finished {
+				log.Printf("teardown of scenario %s for attack %d is lost along with node %s", scenario, attackID, nodeName)
+				continue
+			}
+
+			delete(attack.setups, scenario)
+			close(current.done)
+		}
+	}
+}
+
+// releaseSetups wakes the nodes waiting for the attack-level setups of an ended attack.
+//
+// Parameters:
+//   - attack: The ended attack
+//
+// Must be called with s.mu held.
+func releaseSetups(attack attack) {
+	for _, current := range attack.setups {
+		if !current.finished {
+			close(current.done)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package attack
+
+import (
+	"load-generation-system/internal/core"
+	"maps"
+	"slices"
+)
+
+// ReportStats accepts the request statistics of the attacks running on a node.
+// The statistics are accumulated per attack until they are taken by the attack handlers,
+// and for the whole attack lifetime to summarize it once it ends.
+//
+// Parameters:
+//   - stats: Statistics of the attacks collected by the node since its previous report
+//
+// Statistics of unknown attacks are dropped.
+func (s *attackService) ReportStats(stats []core.AttackStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, reported := range stats {
+		if _, exists := s.attacks[reported.AttackID]; !exists {
+			continue
+		}
+
+		s.stats[reported.AttackID] = mergeStats(s.stats[reported.AttackID], reported)
+		s.totals[reported.AttackID] = mergeStats(s.totals[reported.AttackID], reported)
+	}
+}
+
+// takeStats returns the request statistics of an attack accumulated since the previous take and resets them.
+//
+// Parameters:
+//   - attackID: ID of the attack
+//
+// Returns:
+//   - core.AttackStats: Accumulated statistics of the attack
+func (s *attackService) takeStats(attackID int64) core.AttackStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats[attackID]
+	delete(s.stats, attackID)
+
+	return stats
+}
+
+// mergeStats sums up two statistics of the same attack.
+//
+// Parameters:
+//   - total: Statistics accumulated so far
+//   - reported: Statistics to add
+//
+// Returns:
+//   - core.AttackStats: Sum of the statistics
+func mergeStats(total, reported core.AttackStats) core.AttackStats {
+	if total.Bounds == nil {
+		reported.Buckets = slices.Clone(reported.Buckets)
+		reported.Checks = maps.Clone(reported.Checks)
+		return reported
+	}
+
+	total.Requests += reported.Requests
+	total.Errors += reported.Errors
+	total.SetupFailures += reported.SetupFailures
+	for i := range min(len(total.Buckets), len(reported.Buckets)) {
+		total.Buckets[i] += reported.Buckets[i]
+	}
+	if len(reported.Checks) != 0 && total.Checks == nil {
+		total.Checks = make(map[string]core.CheckStats, len(reported.Checks))
+	}
+	for name, reportedCheck := range reported.Checks {
+		check := total.Checks[name]
+		check.Passed += reportedCheck.Passed
+		check.Failed += reportedCheck.Failed
+		total.Checks[name] = check
+	}
+
+	return total
+}
+
+// errorRate computes the share of failed requests.
+//
+// Parameters:
+//   - stats: Statistics of an attack
+//
+// Returns:
+//   - float64: Share of failed requests, from 0 to 1
+func errorRate(stats core.AttackStats) float64 {
+	if stats.Requests == 0 {
+		return 0
+	}
+
+	return float64(stats.Errors) / float64(stats.Requests)
+}
+
+// quantile estimates a latency quantile from the latency buckets, interpolating linearly within the bucket
+// the quantile falls into. Requests above all bounds are accounted at the highest bound.
+//
+// Parameters:
+//   - stats: Statistics of an attack
+//   - q: The quantile to estimate, from 0 to 1
+//
+// Returns:
+//   - float64: The estimated latency (in seconds)
+func quantile(stats core.AttackStats, q float64) float64 {
+	if stats.Requests == 0 || len(stats.Bounds) == 0 {
+		return 0
+	}
+
+	rank := q * float64(stats.Requests)
+
+	var seen int64
+	for i, count := range stats.Buckets {
+		if i >= len(stats.Bounds) {
+			break
+		}
+
+		if float64(seen+count) >= rank && count != 0 {
+			lower := 0.0
+			if i > 0 {
+				lower = stats.Bounds[i-1]
+			}
+			return lower + (stats.Bounds[i]-lower)*(rank-float64(seen))/float64(count)
+		}
+		seen += count
+	}
+
+	return stats.Bounds[len(stats.Bounds)-1]
+}
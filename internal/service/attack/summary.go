@@ -0,0 +1,126 @@
+package attack
+
+import (
+	"load-generation-system/internal/core"
+	"slices"
+	"time"
+)
+
+// WaitAttack subscribes to the end of an attack, whether it is stopped manually, by its duration
+// or by losing its last increment.
+//
+// Parameters:
+//   - attackID: ID of the attack to wait for
+//
+// Returns:
+//   - <-chan core.AttackSummary: Channel receiving the summary of the attack once it ends
+//   - error: Possible errors:
+//   - core.ErrAttackNotFound if attack doesn't exist
+func (s *attackService) WaitAttack(attackID int64) (<-chan core.AttackSummary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.attacks[attackID]; !exists {
+		return nil, core.ErrAttackNotFound
+	}
+
+	return s.addWaiter(attackID), nil
+}
+
+// addWaiter subscribes to the end of an existing attack.
+//
+// Parameters:
+//   - attackID: ID of the attack to wait for
+//
+// Returns:
+//   - <-chan core.AttackSummary: Channel receiving the summary of the attack once it ends
+//
+// Must be called with s.mu held.
+func (s *attackService) addWaiter(attackID int64) <-chan core.AttackSummary {
+	// The channel is buffered, so ending the attack never blocks on the waiter
+	waiter := make(chan core.AttackSummary, 1)
+	s.waiters[attackID] = append(s.waiters[attackID], waiter)
+
+	return waiter
+}
+
+// GetSummary retrieves the summary of an ended attack.
+//
+// Parameters:
+//   - attackID: ID of the attack
+//
+// Returns:
+//   - core.AttackSummary: Summary of the attack
+//   - error: Possible errors:
+//   - core.ErrAttackRunning if the attack has not ended yet
+//   - core.ErrAttackNotFound if attack doesn't exist or its summary is not kept anymore
+func (s *attackService) GetSummary(attackID int64) (core.AttackSummary, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.attacks[attackID]; exists {
+		return core.AttackSummary{}, core.ErrAttackRunning
+	}
+
+	summary, exists := s.summaries[attackID]
+	if !exists {
+		return core.AttackSummary{}, core.ErrAttackNotFound
+	}
+
+	return summary, nil
+}
+
+// endAttack removes an attack that has ended, stops its handlers and sends its summary to the waiters.
+// The summary is kept along with the ones of the maxSummaries most recent ended attacks.
+//
+// Parameters:
+//   - attack: The ended attack
+//
+// Statistics reported by the nodes after the attack ended are not part of the summary.
+//
+// Must be called with s.mu held.
+func (s *attackService) endAttack(attack attack) {
+	attackID := attack.details.ID
+
+	attack.stopBr.Broadcast(nil)
+	releaseSetups(attack)
+
+	summary := summarize(attackID, s.totals[attackID])
+	for _, waiter := range s.waiters[attackID] {
+		waiter <- summary
+	}
+
+	s.summaries[attackID] = summary
+	s.ended = append(s.ended, attackID)
+	if len(s.ended) > maxSummaries {
+		delete(s.summaries, s.ended[0])
+		s.ended = slices.Delete(s.ended, 0, 1)
+	}
+
+	delete(s.attacks, attackID)
+	delete(s.stats, attackID)
+	delete(s.totals, attackID)
+	delete(s.waiters, attackID)
+	delete(s.pendingFeeds, attackID)
+}
+
+// summarize computes the summary of an ended attack from its request statistics.
+//
+// Parameters:
+//   - attackID: ID of the attack
+//   - stats: Statistics of the whole attack
+//
+// Returns:
+//   - core.AttackSummary: Summary of the attack
+func summarize(attackID int64, stats core.AttackStats) core.AttackSummary {
+	return core.AttackSummary{
+		AttackID:      attackID,
+		EndedAt:       time.Now().UTC().Truncate(time.Second),
+		Requests:      stats.Requests,
+		Errors:        stats.Errors,
+		ErrorRate:     errorRate(stats),
+		P95Ms:         quantile(stats, 0.95) * 1000,
+		Checks:        stats.Checks,
+		SetupFailures: stats.SetupFailures,
+	}
+}
@@ -0,0 +1,169 @@
+package attack
+
+import (
+	"errors"
+	"load-generation-system/internal/core"
+	"log"
+	"slices"
+	"time"
+)
+
+// limitIncrement gives a started increment its time to live and plans its stop.
+// The expiry of an increment started while the attack is paused is counted from the pause,
+// as the whole pause is added to it on resume.
+//
+// Parameters:
+//   - increment: Details of the started increment
+//   - ttlSec: Time to live of the increment in seconds
+//
+// Returns:
+//   - core.IncrementDetails: Details of the increment with its expiry
+//
+// Must be called with s.mu held.
+func (s *attackService) limitIncrement(increment core.IncrementDetails, ttlSec int64) core.IncrementDetails {
+	attack := s.attacks[increment.AttackID]
+
+	from := time.Now()
+	if attack.details.PausedAt != nil {
+		from = *attack.details.PausedAt
+	}
+	expiresAt := from.Add(time.Duration(ttlSec) * time.Second)
+
+	increment.TTLSec = &ttlSec
+	increment.ExpiresAt = &expiresAt
+
+	i := slices.IndexFunc(attack.details.Increments, func(details core.IncrementDetails) bool {
+		return details.ID == increment.ID
+	})
+	increments := slices.Clone(attack.details.Increments)
+	increments[i] = increment
+	attack.details.Increments = increments
+	s.attacks[increment.AttackID] = attack
+
+	go s.handleTTL(attack, increment.ID)
+
+	return increment
+}
+
+// handleTTL stops an increment once its time to live elapses, unless the attack is stopped earlier.
+// The timer follows the expiry stored in the increment details, so it is kept when the increment
+// is moved to other nodes, and the time spent while the attack is paused does not count.
+//
+// Parameters:
+//   - attack: The parent attack of the increment
+//   - incrementID: ID of the increment with a time to live
+func (s *attackService) handleTTL(attack attack, incrementID int64) {
+	stop := attack.stopBr.Subscribe()
+	defer attack.stopBr.Unsubscribe(stop)
+
+	for {
+		paused, changed := attack.pause.state()
+		if paused {
+			select {
+			case <-changed:
+				continue
+			case <-stop:
+				return
+			}
+		}
+
+		expiresAt, exists := s.incrementExpiry(attack.details.ID, incrementID)
+		if !exists {
+			// The increment is already stopped
+			return
+		}
+
+		timer := time.NewTimer(time.Until(expiresAt))
+		select {
+		case <-timer.C:
+			// TTL elapsed - stop the increment, unless it has been postponed meanwhile
+			if s.expireIncrement(attack.details.ID, incrementID) {
+				return
+			}
+		case <-changed:
+			// Paused - the expiry is pushed back on resume
+			timer.Stop()
+		case <-stop:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// incrementExpiry returns the time when the time to live of an increment elapses.
+//
+// Parameters:
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment
+//
+// Returns:
+//   - time.Time: Expiry of the increment
+//   - bool: false if the increment no longer exists
+func (s *attackService) incrementExpiry(attackID, incrementID int64) (time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, increment := range s.attacks[attackID].details.Increments {
+		if increment.ID == incrementID && increment.ExpiresAt != nil {
+			return *increment.ExpiresAt, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// expireIncrement stops an increment whose time to live has elapsed.
+//
+// Parameters:
+//   - attackID: ID of the parent attack
+//   - incrementID: ID of the increment
+//
+// Returns:
+//   - bool: false if the increment must keep running, as the attack is paused or its expiry was pushed back
+func (s *attackService) expireIncrement(attackID, incrementID int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attack, exists := s.attacks[attackID]
+	if !exists {
+		return true
+	}
+	if attack.details.Paused {
+		return false
+	}
+
+	for _, increment := range attack.details.Increments {
+		if increment.ID == incrementID && increment.ExpiresAt != nil && time.Now().Before(*increment.ExpiresAt) {
+			return false
+		}
+	}
+
+	if err := s.stopIncrement(attackID, incrementID, core.StopOptions{}); err != nil && !errors.Is(err, core.ErrIncrementNotFound) {
+		log.Printf("error stopping expired increment %d of attack %d: %v", incrementID, attackID, err)
+	}
+
+	return true
+}
+
+// postponeExpiries pushes back the expiry of the increments with a time to live by the time
+// the attack has spent paused.
+//
+// Parameters:
+//   - attack: The resumed attack, updated in place
+//
+// Must be called with s.mu held.
+func postponeExpiries(attack *attack) {
+	if attack.details.PausedAt == nil {
+		return
+	}
+
+	pause := time.Since(*attack.details.PausedAt)
+	increments := slices.Clone(attack.details.Increments)
+	for i, increment := range increments {
+		if increment.ExpiresAt != nil {
+			expiresAt := increment.ExpiresAt.Add(pause)
+			increments[i].ExpiresAt = &expiresAt
+		}
+	}
+	attack.details.Increments = increments
+}
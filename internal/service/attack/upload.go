@@ -0,0 +1,52 @@
+package attack
+
+import (
+	"load-generation-system/internal/core"
+	"log"
+)
+
+// UploadScenario registers a declarative scenario on every connected node. Uploading a scenario
+// with the name of a previously uploaded one creates its next version, nodes connecting later
+// receive the latest version of every uploaded scenario.
+//
+// Parameters:
+//   - upload: The validated scenario, its version is assigned here
+//
+// Returns:
+//   - core.ScenarioDetails: Details of the uploaded scenario version
+//   - error: Possible errors:
+//   - core.ErrScenarioExists if a node ships a scenario with the same name
+func (s *attackService) UploadScenario(upload core.OperationScenario) (core.ScenarioDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, uploaded := s.uploads[upload.Name]
+	if !uploaded {
+		if _, exists := s.getScenarios()[upload.Name]; exists {
+			return core.ScenarioDetails{}, core.ErrScenarioExists
+		}
+	}
+
+	upload.Version = previous.Version + 1
+	s.uploads[upload.Name] = upload
+	s.distributeScenario(upload)
+
+	return core.ScenarioDetails{
+		Name:        upload.Name,
+		Description: upload.Description,
+		Version:     upload.Version,
+		Parameters:  upload.Parameters,
+	}, nil
+}
+
+// distributeScenario sends an uploaded scenario to all nodes.
+//
+// Parameters:
+//   - upload: The uploaded scenario to distribute
+func (s *attackService) distributeScenario(upload core.OperationScenario) {
+	for nodeName, node := range s.nodes {
+		if err := node.AddScenario(upload); err != nil {
+			log.Printf("impossible to add scenario %s on node %s: %v", upload.Name, nodeName, err)
+		}
+	}
+}
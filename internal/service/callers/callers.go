@@ -1,6 +1,9 @@
 package callers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"load-generation-system/internal/core"
 	"load-generation-system/internal/service/callers/test"
 )
@@ -10,9 +13,17 @@ import (
 //
 // Fields:
 //   - TestCaller: The actual implementation that calls the Test service endpoints
+//   - HTTPClient: HTTP client shared by the callers, used directly by the declarative scenarios
+//   - Target: The target of the attack, nil for the default one
+//   - Feeder: Dataset rows of the attack, nil if the caller is not run by an attack
+//   - Leaser: Client of the lease pools, nil if the caller is not run by a node
 //   - State: Current state of the user
 type Caller struct {
 	TestCaller test.TestCaller // Implementation for calling Test service
+	HTTPClient core.Client     // HTTP client of the user
+	Target     *core.Target    // Target the requests are sent to
+	Feeder     core.Feeder     // Dataset rows of the attack
+	Leaser     core.Leaser     // Lease pools client
 	State      core.State      // Current user state
 }
 
@@ -20,11 +31,111 @@ type Caller struct {
 //
 // Parameters:
 //   - httpClient: Configured HTTP client for communicating
+//   - target: The target of the attack the services are resolved in, nil for the default one
 //
 // Returns:
 //   - *Caller: Initialized client ready to call target services endpoints
-func NewCaller(httpClient core.Client) *Caller {
+func NewCaller(httpClient core.Client, target *core.Target) *Caller {
 	return &Caller{
-		TestCaller: test.NewCaller(httpClient),
+		TestCaller: test.NewCaller(httpClient, target),
+		HTTPClient: httpClient,
+		Target:     target,
 	}
 }
+
+// R creates a request bound to the state of the user. The {{name}} references of the request
+// are rendered with the user parameters, and the values taken by its extractors are stored in them,
+// so that a token or an ID returned by a response can be passed to the next requests.
+//
+// Returns:
+//   - core.Request: A new request of the user HTTP client
+func (c *Caller) R() core.Request {
+	return c.HTTPClient.R().SetState(&c.State)
+}
+
+// Row returns the next row of a dataset fed to the attack, according to the feed mode.
+//
+// Parameters:
+//   - dataset: Name of the dataset
+//
+// Returns:
+//   - core.DataRow: The row values indexed by column name
+//   - error: core.ErrDatasetNotFound if the dataset is not fed to the attack,
+//     core.ErrFeedExhausted once the unique rows of the node are used up
+func (c *Caller) Row(dataset string) (core.DataRow, error) {
+	if c.Feeder == nil {
+		return nil, fmt.Errorf("%w: %s is not fed to the attack", core.ErrDatasetNotFound, dataset)
+	}
+
+	return c.Feeder.Next(dataset)
+}
+
+// Lease returns the resource of a lease pool held by the user. A user holding none acquires one first,
+// waiting until one is released or the context is done. The resource stays with the user for its whole
+// session, until it is released or the user is destroyed.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//   - pool: Name of the lease pool
+//
+// Returns:
+//   - string: The leased resource
+//   - error: core.ErrLeasePoolNotFound if the pool doesn't exist, the context error if it is done first
+func (c *Caller) Lease(ctx context.Context, pool string) (string, error) {
+	if lease, exists := c.State.Leases[pool]; exists {
+		return lease.Resource, nil
+	}
+	if c.Leaser == nil {
+		return "", fmt.Errorf("%w: %s is not reachable from the caller", core.ErrLeasePoolNotFound, pool)
+	}
+
+	lease, err := c.Leaser.Acquire(ctx, pool)
+	if err != nil {
+		return "", err
+	}
+
+	if c.State.Leases == nil {
+		c.State.Leases = make(map[string]core.Lease)
+	}
+	c.State.Leases[pool] = lease
+
+	return lease.Resource, nil
+}
+
+// Release returns the resource of a lease pool held by the user. It does nothing if the user holds none.
+//
+// Parameters:
+//   - ctx: Context of the release
+//   - pool: Name of the lease pool
+//
+// Returns:
+//   - error: Any error that occurs while releasing the lease, except it being already released
+func (c *Caller) Release(ctx context.Context, pool string) error {
+	lease, exists := c.State.Leases[pool]
+	if !exists {
+		return nil
+	}
+	delete(c.State.Leases, pool)
+
+	if err := c.Leaser.Release(ctx, lease); err != nil && !errors.Is(err, core.ErrLeaseNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseLeases returns the resources of all the lease pools held by the user.
+//
+// Parameters:
+//   - ctx: Context of the releases
+//
+// Returns:
+//   - error: The errors that occur while releasing the leases
+func (c *Caller) ReleaseLeases(ctx context.Context) error {
+	var errs []error
+	for pool := range c.State.Leases {
+		errs = append(errs, c.Release(ctx, pool))
+	}
+
+	return errors.Join(errs...)
+}
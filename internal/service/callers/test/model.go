@@ -9,6 +9,9 @@ type TestCaller interface {
 }
 
 const (
+	// Service is the name of the Test service in the attack targets.
+	Service = "test"
+
 	host    = "localhost:8090"
 	path    = "/test/api"
 	version = "/v1"
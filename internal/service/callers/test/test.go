@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"load-generation-system/internal/core"
+	"strings"
 )
 
 type testCaller struct {
@@ -10,11 +11,28 @@ type testCaller struct {
 	httpClient core.Client
 }
 
+// NewCaller creates a caller of the Test service.
+// The service is called at its base URL in the target, or at localhost when the target does not define it.
+//
+// Parameters:
+//   - httpClient: Configured HTTP client for communicating
+//   - target: The target of the attack, nil for the default one
+//
+// Returns:
+//   - TestCaller: Caller of the Test service endpoints
 func NewCaller(
 	httpClient core.Client,
+	target *core.Target,
 ) TestCaller {
+	baseURL := protocol + host
+	if target != nil {
+		if url, ok := target.Services[Service]; ok {
+			baseURL = strings.TrimSuffix(url, "/")
+		}
+	}
+
 	return &testCaller{
-		urlBase:    protocol + host + path,
+		urlBase:    baseURL + path,
 		httpClient: httpClient,
 	}
 }
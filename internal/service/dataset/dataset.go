@@ -0,0 +1,127 @@
+package dataset
+
+import (
+	"load-generation-system/internal/core"
+	"sync"
+	"time"
+)
+
+// datasetService implements core.DatasetService and keeps the datasets the attacks feed their users from.
+//
+// Fields:
+//   - datasets: Datasets indexed by name
+//   - mu: Read-write mutex for concurrent access protection
+type datasetService struct {
+	datasets map[string]dataset // Datasets
+	mu       sync.RWMutex       // Concurrency control
+}
+
+// dataset is an uploaded dataset along with its upload time.
+type dataset struct {
+	core.Dataset
+	uploadedAt time.Time
+}
+
+// NewService creates a new dataset service instance.
+//
+// Returns:
+//   - core.DatasetService: Initialized dataset service
+func NewService() core.DatasetService {
+	return &datasetService{
+		datasets: make(map[string]dataset),
+	}
+}
+
+// UploadDataset stores a dataset or replaces the one with the same name.
+// Attacks already fed from the previous version of the dataset keep its rows.
+//
+// Parameters:
+//   - upload: The parsed dataset
+//
+// Returns:
+//   - core.DatasetDetails: Details of the stored dataset
+//   - error: core.ErrBadDataset if the dataset has no rows
+func (s *datasetService) UploadDataset(upload core.Dataset) (core.DatasetDetails, error) {
+	if len(upload.Rows) == 0 {
+		return core.DatasetDetails{}, core.ErrBadDataset
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := dataset{
+		Dataset:    upload,
+		uploadedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	s.datasets[upload.Name] = stored
+
+	return stored.details(), nil
+}
+
+// GetDataset retrieves a dataset along with its rows. The rows must not be modified.
+//
+// Parameters:
+//   - name: Name of the dataset
+//
+// Returns:
+//   - core.Dataset: The dataset
+//   - error: Possible errors:
+//   - core.ErrDatasetNotFound if the dataset doesn't exist
+func (s *datasetService) GetDataset(name string) (core.Dataset, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, exists := s.datasets[name]
+	if !exists {
+		return core.Dataset{}, core.ErrDatasetNotFound
+	}
+
+	return stored.Dataset, nil
+}
+
+// GetDatasets retrieves the details of all datasets.
+//
+// Returns:
+//   - []core.DatasetDetails: A slice containing details of all datasets
+func (s *datasetService) GetDatasets() []core.DatasetDetails {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	datasets := make([]core.DatasetDetails, 0, len(s.datasets))
+	for _, stored := range s.datasets {
+		datasets = append(datasets, stored.details())
+	}
+
+	return datasets
+}
+
+// DeleteDataset removes a dataset. Attacks already fed from it keep their rows.
+//
+// Parameters:
+//   - name: Name of the dataset to delete
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrDatasetNotFound if the dataset doesn't exist
+func (s *datasetService) DeleteDataset(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.datasets[name]; !exists {
+		return core.ErrDatasetNotFound
+	}
+	delete(s.datasets, name)
+
+	return nil
+}
+
+// details describes the dataset without its rows.
+func (d dataset) details() core.DatasetDetails {
+	return core.DatasetDetails{
+		Name:       d.Name,
+		Format:     d.Format,
+		Columns:    d.Columns,
+		RowCount:   len(d.Rows),
+		UploadedAt: d.uploadedAt,
+	}
+}
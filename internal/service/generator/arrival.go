@@ -0,0 +1,283 @@
+package generator
+
+import (
+	"context"
+	"load-generation-system/internal/core"
+	"load-generation-system/internal/metrics"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// idleRateCheckInterval is the interval between rate checks while the arrival rate is zero or the attack is paused
+const idleRateCheckInterval = 100 * time.Millisecond
+
+// arrivalExecutor starts iterations of a single scenario at a given arrival rate (open model).
+// Iterations are served by a pool of users that grows on demand up to maxUsers. An iteration
+// that finds neither a free user nor room to grow the pool is dropped.
+type arrivalExecutor struct {
+	scenario   string           // Name of the scenario the executor starts
+	rate       core.ArrivalRate // Arrival rate of the scenario iterations
+	maxUsers   int64            // Upper bound of the users pool
+	paused     *atomic.Bool     // Whether the attack of the executor is paused
+	newUser    func() *user     // Factory creating users for the pool
+	retireUser func(u *user)    // Callback destroying users removed from the pool
+
+	users    []*user    // All users created by the executor
+	idle     chan *user // Users ready to start the next iteration
+	rescaled chan any   // Signals the run loop that the arrival rate has been replaced
+	closed   bool       // Whether the executor has been closed
+	mu       sync.Mutex // Mutex to protect the users pool
+}
+
+func newArrivalExecutor(
+	scenario string,
+	rate core.ArrivalRate,
+	maxUsers int64,
+	paused *atomic.Bool,
+	newUser func() *user,
+	retireUser func(u *user),
+) *arrivalExecutor {
+	return &arrivalExecutor{
+		scenario:   scenario,
+		rate:       rate,
+		maxUsers:   maxUsers,
+		paused:     paused,
+		newUser:    newUser,
+		retireUser: retireUser,
+		idle:       make(chan *user, maxUsers),
+		rescaled:   make(chan any, 1),
+	}
+}
+
+// run starts iterations at the configured rate until the context is canceled.
+//
+// Parameters:
+//   - ctx: The context of the increment the executor belongs to
+func (e *arrivalExecutor) run(ctx context.Context) {
+	started := time.Now()
+	next := started
+	var last time.Time
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.rescaled:
+			// The ramp of the new rate starts now, the next arrival is planned at that rate from the last one
+			started = time.Now()
+			e.mu.Lock()
+			rate := e.currentRate(0)
+			e.mu.Unlock()
+
+			next = started
+			if rate > 0 && !last.IsZero() {
+				if planned := last.Add(time.Duration(float64(time.Second) / rate)); planned.After(next) {
+					next = planned
+				}
+			}
+			timer.Reset(time.Until(next))
+			continue
+		case <-timer.C:
+		}
+
+		// Time spent paused is not part of the ramp
+		if e.paused.Load() {
+			started = started.Add(idleRateCheckInterval)
+			next = time.Now().Add(idleRateCheckInterval)
+			timer.Reset(idleRateCheckInterval)
+			continue
+		}
+
+		e.mu.Lock()
+		rate := e.currentRate(time.Since(started))
+		e.mu.Unlock()
+		if rate <= 0 {
+			next = time.Now().Add(idleRateCheckInterval)
+			timer.Reset(idleRateCheckInterval)
+			continue
+		}
+
+		last = time.Now()
+		e.dispatch(ctx)
+
+		// Schedule the next arrival from the planned time to avoid drifting
+		next = next.Add(time.Duration(float64(time.Second) / rate))
+		timer.Reset(time.Until(next))
+	}
+}
+
+// currentRate computes the arrival rate at the given moment of the ramp.
+//
+// Parameters:
+//   - elapsed: Time passed since the executor was started
+//
+// Returns:
+//   - float64: Iterations per second to start at this moment
+//
+// Must be called with e.mu held.
+func (e *arrivalExecutor) currentRate(elapsed time.Duration) float64 {
+	rampUp := time.Duration(e.rate.RampUpSec) * time.Second
+	if rampUp <= 0 || elapsed >= rampUp {
+		return e.rate.TargetRate
+	}
+
+	progress := float64(elapsed) / float64(rampUp)
+	return e.rate.Rate + (e.rate.TargetRate-e.rate.Rate)*progress
+}
+
+// dispatch starts a single iteration on a free user, growing the pool if there is none.
+// The iteration is dropped if the pool is exhausted.
+//
+// Parameters:
+//   - ctx: The context of the increment the executor belongs to
+func (e *arrivalExecutor) dispatch(ctx context.Context) {
+	var u *user
+
+	e.mu.Lock()
+	idle := e.idle
+	e.mu.Unlock()
+
+	select {
+	case u = <-idle:
+	default:
+		e.mu.Lock()
+		if !e.closed && int64(len(e.users)) < e.maxUsers {
+			u = e.newUser()
+			e.users = append(e.users, u)
+		}
+		e.mu.Unlock()
+	}
+
+	if u == nil {
+		metrics.DroppedIterationsCounter.WithLabelValues(e.scenario).Inc()
+		return
+	}
+
+	go func() {
+		u.Run(ctx)
+		e.release(u)
+	}()
+}
+
+// release returns a user to the pool once its iteration is over.
+// The user is retired instead if the pool has been shrunk below its current size.
+//
+// Parameters:
+//   - u: The user that finished its iteration
+func (e *arrivalExecutor) release(u *user) {
+	e.mu.Lock()
+	if !e.closed && int64(len(e.users)) > e.maxUsers {
+		e.users = slices.DeleteFunc(e.users, func(pooled *user) bool {
+			return pooled == u
+		})
+		e.mu.Unlock()
+
+		e.retireUser(u)
+		return
+	}
+	defer e.mu.Unlock()
+
+	// The idle channel always has room for the whole pool
+	e.idle <- u
+}
+
+// grow raises the bound of the users pool and the arrival rate of the executor.
+// The idle channel is replaced by a larger one if it cannot hold the whole pool anymore.
+//
+// Parameters:
+//   - amount: The number of users to add to the pool bound
+//   - rate: The arrival rate to add to the current one
+func (e *arrivalExecutor) grow(amount int64, rate core.ArrivalRate) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.maxUsers += amount
+	e.rate.Rate += rate.Rate
+	e.rate.TargetRate += rate.TargetRate
+
+	if int64(cap(e.idle)) >= e.maxUsers {
+		return
+	}
+
+	idle := make(chan *user, e.maxUsers)
+	for {
+		select {
+		case u := <-e.idle:
+			idle <- u
+		default:
+			e.idle = idle
+			return
+		}
+	}
+}
+
+// rescale replaces the arrival rate of the executor, keeping its users pool.
+//
+// Parameters:
+//   - rate: The new arrival rate, its ramp starts when the executor picks it up
+func (e *arrivalExecutor) rescale(rate core.ArrivalRate) {
+	e.mu.Lock()
+	e.rate = rate
+	e.mu.Unlock()
+
+	select {
+	case e.rescaled <- nil:
+	default:
+		// The run loop has not picked up the previous rate yet, it reads the new one instead
+	}
+}
+
+// shrink lowers the bound of the users pool and removes the idle users exceeding it.
+// Busy users exceeding the bound are retired once their iteration is over.
+//
+// Parameters:
+//   - amount: The number of users to remove from the pool bound
+//
+// Returns:
+//   - []*user: Removed idle users, which are to be destroyed by the caller
+func (e *arrivalExecutor) shrink(amount int64) []*user {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.maxUsers = max(e.maxUsers-amount, 0)
+
+	var retired []*user
+	for int64(len(e.users)) > e.maxUsers {
+		select {
+		case u := <-e.idle:
+			e.users = slices.DeleteFunc(e.users, func(pooled *user) bool {
+				return pooled == u
+			})
+			retired = append(retired, u)
+		default:
+			return retired
+		}
+	}
+
+	return retired
+}
+
+// size returns the current bound of the users pool.
+func (e *arrivalExecutor) size() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.maxUsers
+}
+
+// close prevents the pool from growing and returns all users created by the executor.
+//
+// Returns:
+//   - []*user: Users of the pool, which are to be destroyed by the caller
+func (e *arrivalExecutor) close() []*user {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.closed = true
+	return e.users
+}
@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"sync"
+)
+
+// iterationBudget bounds the number of iterations run by the closed model users of an increment.
+// Once every allowed iteration is completed, the budget reports the completion exactly once,
+// until new iterations or users are added to it.
+type iterationBudget struct {
+	total     *int64                // Iterations the users run in total, nil if not bounded
+	perUser   int64                 // Iterations each user runs, zero if not bounded
+	users     int64                 // Number of users sharing the budget
+	finished  int64                 // Number of users that have run all their iterations
+	started   int64                 // Number of started iterations
+	completed int64                 // Number of completed iterations
+	done      bool                  // Whether the completion has been reported
+	onDone    func(completed int64) // Callback reporting the completion
+	mu        sync.Mutex            // Mutex to protect the counters
+}
+
+func newIterationBudget(perUser int64, onDone func(completed int64)) *iterationBudget {
+	return &iterationBudget{
+		perUser: perUser,
+		onDone:  onDone,
+	}
+}
+
+// extend adds iterations and users to the budget. A budget that is already spent
+// reports the completion right away.
+//
+// Parameters:
+//   - iterations: Iterations added to the total, nil if the total is not changed
+//   - users: Number of users added to the budget
+func (b *iterationBudget) extend(iterations *int64, users int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if iterations != nil {
+		total := *iterations
+		if b.total != nil {
+			total += *b.total
+		}
+		b.total = &total
+	}
+	b.users += users
+
+	// New work reopens a reported budget
+	if b.done && !b.spent() {
+		b.done = false
+	}
+	b.check()
+}
+
+// remove takes a retired user out of the budget. Iterations the user has completed still count
+// towards the total.
+//
+// Parameters:
+//   - u: The retired user
+func (b *iterationBudget) remove(u *user) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if u.retired {
+		return
+	}
+
+	u.retired = true
+	b.users--
+	if b.perUser > 0 && u.completed >= b.perUser {
+		b.finished--
+	}
+	b.check()
+}
+
+// reserve claims an iteration for a user.
+//
+// Parameters:
+//   - u: The user about to start an iteration
+//
+// Returns:
+//   - bool: false if the user must not start another iteration
+func (b *iterationBudget) reserve(u *user) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.perUser > 0 && u.started >= b.perUser {
+		return false
+	}
+	if b.total != nil && b.started >= *b.total {
+		return false
+	}
+
+	b.started++
+	u.started++
+
+	return true
+}
+
+// complete records a finished iteration of a user and reports the completion once the budget is spent.
+//
+// Parameters:
+//   - u: The user that has finished the iteration
+func (b *iterationBudget) complete(u *user) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.completed++
+	u.completed++
+	if b.perUser > 0 && u.completed == b.perUser && !u.retired {
+		b.finished++
+	}
+	b.check()
+}
+
+// check reports the completion if the budget is spent and it has not been reported yet.
+//
+// Must be called with b.mu held.
+func (b *iterationBudget) check() {
+	if !b.done && b.spent() {
+		b.done = true
+		b.onDone(b.completed)
+	}
+}
+
+// spent tells whether the total is reached or every user has run all its iterations.
+//
+// Returns:
+//   - bool: Whether the budget is spent
+//
+// Must be called with b.mu held.
+func (b *iterationBudget) spent() bool {
+	if b.total != nil && b.completed >= *b.total {
+		return true
+	}
+
+	return b.perUser > 0 && b.finished >= b.users
+}
@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"fmt"
+	"load-generation-system/internal/core"
+	"math/rand/v2"
+	"sync"
+)
+
+// feeder hands out the dataset rows a node has received for an attack to the users of the attack.
+type feeder struct {
+	feeds map[string]*feed // Feeds indexed by dataset name
+	mu    sync.Mutex       // Mutex to protect the cursors
+}
+
+// feed holds the rows of a dataset along with the position of the next row to hand out.
+type feed struct {
+	mode core.FeedMode // The way the rows are handed out
+	rows []core.DataRow
+	next int // Index of the next row of the sequential modes
+}
+
+func newFeeder() *feeder {
+	return &feeder{
+		feeds: make(map[string]*feed),
+	}
+}
+
+// add appends the rows the node has received with an operation, such as the rows taken over from a lost node.
+// Every node holds the whole dataset of a random feed, so its rows are only taken once.
+//
+// Parameters:
+//   - shares: Dataset rows of the operation
+func (f *feeder) add(shares []core.FeedShare) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, share := range shares {
+		existing, exists := f.feeds[share.Dataset]
+		if !exists {
+			f.feeds[share.Dataset] = &feed{
+				mode: share.Mode,
+				rows: share.Rows,
+			}
+			continue
+		}
+
+		if share.Mode != core.FeedRandom {
+			existing.rows = append(existing.rows[:len(existing.rows):len(existing.rows)], share.Rows...)
+		}
+	}
+}
+
+// Next returns the next row of a dataset for a user.
+//
+// Parameters:
+//   - dataset: Name of the dataset
+//
+// Returns:
+//   - core.DataRow: The row to use
+//   - error: core.ErrDatasetNotFound if the node has no rows of the dataset,
+//     core.ErrFeedExhausted once every unique row is handed out
+func (f *feeder) Next(dataset string) (core.DataRow, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	current, exists := f.feeds[dataset]
+	if !exists || len(current.rows) == 0 {
+		return nil, fmt.Errorf("%w: %s has no rows on this node", core.ErrDatasetNotFound, dataset)
+	}
+
+	switch current.mode {
+	case core.FeedRandom:
+		return current.rows[rand.IntN(len(current.rows))], nil
+	case core.FeedCircular:
+		row := current.rows[current.next%len(current.rows)]
+		current.next++
+		return row, nil
+	default:
+		if current.next >= len(current.rows) {
+			return nil, fmt.Errorf("%w: %s", core.ErrFeedExhausted, dataset)
+		}
+		row := current.rows[current.next]
+		current.next++
+		return row, nil
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"load-generation-system/internal/core"
+	"load-generation-system/internal/metrics"
 	"load-generation-system/internal/scenarios"
 	"load-generation-system/internal/service/callers"
 	"load-generation-system/internal/service/http"
 	"load-generation-system/pkg/scheduler"
 	"log"
+	"maps"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -17,16 +21,33 @@ import (
 type increment struct {
 	operationID string             // Unique identifier for the operation
 	users       []*user            // Collection of virtual users in this increment
+	executors   []*arrivalExecutor // Arrival-rate executors of the open model scenarios
+	budget      *iterationBudget   // Iteration budget of the closed model users, nil if not bounded
 	ctx         context.Context    // Context for managing increment lifecycle
 	cancel      context.CancelFunc // Function to cancel the increment
 }
 
+// allUsers returns every user of the increment, including the users pools of its arrival-rate executors.
+// The executors are closed, so it must only be called once the increment is stopped.
+func (inc increment) allUsers() []*user {
+	users := slices.Clone(inc.users)
+	for _, executor := range inc.executors {
+		users = append(users, executor.close()...)
+	}
+
+	return users
+}
+
 // attack represents a complete load test consisting of multiple increments
 type attack struct {
-	increments map[int64]increment // Map of increments by their IDs
-	ctx        context.Context     // Context for managing attack lifecycle
-	cancel     context.CancelFunc  // Function to cancel the attack
-	jobID      string              // Scheduler job identifier
+	increments map[int64]increment    // Map of increments by their IDs
+	ctx        context.Context        // Context for managing attack lifecycle
+	cancel     context.CancelFunc     // Function to cancel the attack
+	jobID      string                 // Scheduler job identifier
+	paused     *atomic.Bool           // Whether new iterations are suspended, shared with the arrival-rate executors
+	feeder     *feeder                // Dataset rows of the node, shared by the users of the attack
+	hooks      map[string]*attackHook // Attack-level hooks of the scenarios, indexed by scenario name
+	retiring   *sync.WaitGroup        // Users of the stopped and reduced increments still being destroyed
 }
 
 // Config contains configuration parameters for the load generator
@@ -38,28 +59,35 @@ type Config struct {
 
 // generator is the main implementation of the LoadGenerator interface
 type generator struct {
-	attacks   map[int64]attack     // Active attacks indexed by attack ID
-	mu        sync.RWMutex         // Mutex for concurrent access to attacks
-	ctx       context.Context      // Root context for the generator
-	cancel    context.CancelFunc   // Function to shutdown the generator
-	stop      sync.WaitGroup       // WaitGroup for graceful shutdown
-	scheduler *scheduler.Scheduler // Job scheduler for attack execution
-	config    Config               // Generator configuration
+	attacks     map[int64]attack              // Active attacks indexed by attack ID
+	mu          sync.RWMutex                  // Mutex for concurrent access to attacks
+	ctx         context.Context               // Root context for the generator
+	cancel      context.CancelFunc            // Function to shutdown the generator
+	stop        sync.WaitGroup                // WaitGroup for graceful shutdown
+	scheduler   *scheduler.Scheduler          // Job scheduler for attack execution
+	config      Config                        // Generator configuration
+	completions chan core.IncrementCompletion // Completions of the iteration-bounded increments
+	leaser      core.Leaser                   // Lease pools client shared by the users
+	coordinator core.SetupCoordinator         // Client claiming the attack-level setups on the manager
 }
 
-func New(config Config) core.LoadGenerator {
+func New(config Config, leaser core.Leaser, coordinator core.SetupCoordinator) core.LoadGenerator {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &generator{
-		attacks:   make(map[int64]attack),
-		ctx:       ctx,
-		cancel:    cancel,
-		scheduler: scheduler.New(),
-		config:    config,
+		attacks:     make(map[int64]attack),
+		ctx:         ctx,
+		cancel:      cancel,
+		scheduler:   scheduler.New(),
+		config:      config,
+		completions: make(chan core.IncrementCompletion),
+		leaser:      leaser,
+		coordinator: coordinator,
 	}
 }
 
-// StartAttack initiates a new attack or adds an increment to an existing attack
+// StartAttack initiates a new attack, adds an increment to an existing attack
+// or adds users to an existing increment
 //
 // Parameters:
 //   - start: Operation details including attack ID, increment ID, and scenarios
@@ -83,12 +111,17 @@ func (g *generator) StartAttack(start core.OperationStart) error {
 			return fmt.Errorf("failed to schedule attack %d: %v", start.AttackID, err)
 		}
 
-		ctx, cancel := context.WithCancel(g.ctx)
+		// Requests made within the attack context are collected into its statistics
+		ctx, cancel := context.WithCancel(metrics.WithAttack(g.ctx, start.AttackID))
 		att = attack{
 			increments: make(map[int64]increment),
 			ctx:        ctx,
 			cancel:     cancel,
 			jobID:      jobID,
+			paused:     &atomic.Bool{},
+			feeder:     newFeeder(),
+			hooks:      make(map[string]*attackHook),
+			retiring:   &sync.WaitGroup{},
 		}
 		g.attacks[start.AttackID] = att
 	}
@@ -101,44 +134,198 @@ func (g *generator) StartAttack(start core.OperationStart) error {
 		)
 	}
 
+	// A start of an existing increment adds users to it, keeping the running ones
+	inc, exists := att.increments[start.IncrementID]
+	if !exists {
+		ctx, cancel := context.WithCancel(att.ctx)
+		inc = increment{
+			ctx:    ctx,
+			cancel: cancel,
+		}
+	}
+	inc.operationID = start.ID
+	att.feeder.add(start.Feeds)
+
+	// Iteration-bounded increments share a budget between their closed model users
+	if inc.budget == nil && (start.Iterations != nil || start.IterationsPerUser > 0) {
+		attackID, incrementID := start.AttackID, start.IncrementID
+		inc.budget = newIterationBudget(start.IterationsPerUser, func(completed int64) {
+			g.complete(core.IncrementCompletion{
+				AttackID:    attackID,
+				IncrementID: incrementID,
+				Iterations:  completed,
+			})
+		})
+	}
+
 	// Create users for each scenario
-	var users []*user
-	var httpClient core.Client
+	var executors []*arrivalExecutor
+	var paced []*user
+	var users int64
 
 	for name, count := range start.Scenarios {
-		scenario, ok := scenarios.AvailableScenarios[name]
+		scenario, ok := scenarios.Lookup(name)
 		if !ok {
 			log.Printf("scenario %s is not existed! It will be skipped", name)
 			continue
 		}
 
+		// Open model scenarios already running in the increment grow their users pool and rate
+		index := slices.IndexFunc(inc.executors, func(executor *arrivalExecutor) bool {
+			return executor.scenario == name
+		})
+		if index != -1 {
+			inc.executors[index].grow(count, start.ArrivalRates[name])
+			continue
+		}
+
+		// The manager has checked the parameters against the schema, this converts them to their types
+		params, err := scenarios.ResolveParams(scenario.Parameters, start.Params[name])
+		if err != nil {
+			log.Printf("parameters of scenario %s are invalid: %v. It will be skipped", name, err)
+			continue
+		}
+
+		// The attack-level hooks of the scenario are run once for the whole attack
+		hook, exists := att.hooks[name]
+		if !exists && (scenario.AttackSetup != nil || scenario.AttackTeardown != nil) {
+			caller := g.newCaller(g.newClient(start.Target), start.Target, params, att.feeder)
+			hook = newAttackHook(att.ctx, start.AttackID, scenario, caller, g.coordinator)
+			att.hooks[name] = hook
+		}
+
+		// Open model scenarios grow their users pool on demand
+		if rate, ok := start.ArrivalRates[name]; ok {
+			executors = append(executors, newArrivalExecutor(name, rate, count, att.paused, g.userFactory(scenario, nil, hook, start.Target, params, att.feeder), g.retireUser))
+			continue
+		}
+
+		newScenarioUser := g.userFactory(scenario, inc.budget, hook, start.Target, params, att.feeder)
+		_, isPaced := start.Pacings[name]
 		for i := int64(0); i < count; i++ {
-			// Create new HTTP client when needed
-			if i%g.config.UsersPerClient == 0 {
-				httpClient = http.NewClient(
-					g.config.MinIdleConnTimeoutSec,
-					g.config.MaxIdleConnTimeoutSec,
-				)
+			u := newScenarioUser()
+			u.paced = isPaced
+			inc.users = append(inc.users, u)
+			if isPaced {
+				paced = append(paced, u)
 			}
-
-			caller := callers.NewCaller(httpClient)
-			users = append(users, newUser(fmt.Sprintf("user for %s #%d", name, i), scenario, caller))
-			g.stop.Add(1)
 		}
+		users += count
 	}
 
-	// Create and store the new increment
-	ctx, cancel := context.WithCancel(att.ctx)
-	att.increments[start.IncrementID] = increment{
-		operationID: start.ID,
-		users:       users,
-		ctx:         ctx,
-		cancel:      cancel,
+	if inc.budget != nil {
+		inc.budget.extend(start.Iterations, users)
+	}
+
+	// Store the increment, new users are started by the next attack execution
+	inc.executors = append(inc.executors, executors...)
+	att.increments[start.IncrementID] = inc
+
+	for _, executor := range executors {
+		go executor.run(inc.ctx)
+	}
+
+	// Paced users run on their own schedule instead of the attack execution
+	for _, u := range paced {
+		go runPaced(inc.ctx, u, start.Pacings[u.scenario.Name], att.paused)
 	}
 
 	return nil
 }
 
+// userFactory returns a function creating users of the given scenario.
+// Every UsersPerClient consecutive users share a single HTTP client.
+//
+// Parameters:
+//   - scenario: The scenario the created users run
+//   - budget: The iteration budget the created users share, nil if not bounded
+//   - hook: The attack-level hooks of the scenario the created users wait for, nil if the scenario has none
+//   - target: The target the created users send their requests to, nil for the default one
+//   - params: Parameters of the scenario, every user gets its own copy in its state
+//   - feeder: Dataset rows of the attack the created users read
+//
+// Returns:
+//   - func() *user: Factory creating a new user on each call
+func (g *generator) userFactory(
+	scenario scenarios.Scenario,
+	budget *iterationBudget,
+	hook *attackHook,
+	target *core.Target,
+	params core.Params,
+	feeder core.Feeder,
+) func() *user {
+	var i int64
+	var httpClient core.Client
+
+	return func() *user {
+		// Create new HTTP client when needed
+		if i%g.config.UsersPerClient == 0 {
+			httpClient = g.newClient(target)
+		}
+
+		caller := g.newCaller(httpClient, target, params, feeder)
+		u := newUser(fmt.Sprintf("user for %s #%d", scenario.Name, i), scenario, caller, budget, hook)
+		g.stop.Add(1)
+		i++
+
+		return u
+	}
+}
+
+// newClient creates an HTTP client sending requests to a target.
+//
+// Parameters:
+//   - target: The target the requests are sent to, nil for the default one
+//
+// Returns:
+//   - core.Client: The HTTP client
+func (g *generator) newClient(target *core.Target) core.Client {
+	return http.NewClient(
+		g.config.MinIdleConnTimeoutSec,
+		g.config.MaxIdleConnTimeoutSec,
+		targetHeaders(target),
+	)
+}
+
+// newCaller creates the caller of a user or of the attack-level hooks of a scenario.
+//
+// Parameters:
+//   - httpClient: The HTTP client of the caller
+//   - target: The target the requests are sent to, nil for the default one
+//   - params: Parameters of the scenario, the caller gets its own copy in its state
+//   - feeder: Dataset rows of the attack the caller reads
+//
+// Returns:
+//   - *callers.Caller: The caller
+func (g *generator) newCaller(
+	httpClient core.Client,
+	target *core.Target,
+	params core.Params,
+	feeder core.Feeder,
+) *callers.Caller {
+	caller := callers.NewCaller(httpClient, target)
+	caller.State.Params = maps.Clone(params)
+	caller.Feeder = feeder
+	caller.Leaser = g.leaser
+
+	return caller
+}
+
+// targetHeaders returns the default headers of the requests sent to a target.
+//
+// Parameters:
+//   - target: The target of the attack, nil for the default one
+//
+// Returns:
+//   - map[string]string: The headers of the target, nil if there are none
+func targetHeaders(target *core.Target) map[string]string {
+	if target == nil {
+		return nil
+	}
+
+	return target.Headers
+}
+
 // executeAttack coordinates the execution of all users in an attack with proper pacing
 //
 // Parameters:
@@ -149,14 +336,18 @@ func (g *generator) executeAttack(attackID int64, waitTimeSec float64) {
 	defer g.mu.RUnlock()
 
 	att, exists := g.attacks[attackID]
-	if !exists {
+	if !exists || att.paused.Load() {
 		return
 	}
 
-	// Calculate total users and start interval
+	// Calculate total users and start interval, paced users run on their own schedule
 	var userCounter int
 	for _, increment := range att.increments {
-		userCounter += len(increment.users)
+		for _, user := range increment.users {
+			if !user.paced {
+				userCounter++
+			}
+		}
 	}
 	if userCounter == 0 {
 		return
@@ -167,13 +358,17 @@ func (g *generator) executeAttack(attackID int64, waitTimeSec float64) {
 	// Start users with calculated interval
 	for _, increment := range att.increments {
 		for _, user := range increment.users {
+			if user.paced {
+				continue
+			}
 			go user.Run(increment.ctx)
 			time.Sleep(interval)
 		}
 	}
 }
 
-// StopAttack terminates either a specific increment or an entire attack
+// StopAttack terminates either a specific increment or an entire attack.
+// With a drain timeout, the running iterations are given the time to finish before they are cancelled.
 //
 // Parameters:
 //   - stop: Operation details including what to stop
@@ -189,55 +384,323 @@ func (g *generator) StopAttack(stop core.OperationStop) error {
 		return core.ErrAttackNotFound
 	}
 
+	var drainTimeout time.Duration
+	if stop.DrainTimeoutSec != nil {
+		drainTimeout = time.Duration(*stop.DrainTimeoutSec) * time.Second
+	}
+
 	if stop.IncrementID != nil {
 		// Stop specific increment
-		increments := g.attacks[stop.AttackID].increments
-		increment, exists := increments[*stop.IncrementID]
-		if !exists {
-			return core.ErrAttackNotFound
-		}
-
-		increment.cancel()
-		delete(increments, *stop.IncrementID)
-
-		// Clean up users
-		for _, user := range increment.users {
-			go func() {
-				defer g.stop.Done()
-				user.Destroy(g.ctx)
-			}()
-		}
-
-		// Clean up attack if no increments remain
-		if len(increments) == 0 {
-			attack.cancel()
-			if err := g.scheduler.RemoveJob(g.attacks[stop.AttackID].jobID); err != nil {
-				return fmt.Errorf("unable to remove attack job: %v", err)
-			}
-			delete(g.attacks, stop.AttackID)
-		}
+		return g.stopIncrement(stop.AttackID, *stop.IncrementID, drainTimeout)
 	} else {
 		// Stop entire attack
-		attack.cancel()
 		if err := g.scheduler.RemoveJob(g.attacks[stop.AttackID].jobID); err != nil {
+			attack.cancel()
 			return fmt.Errorf("unable to remove attack job: %v", err)
 		}
 		delete(g.attacks, stop.AttackID)
 
-		// Clean up all users
+		// Clean up all users, then tear the attack down
+		var users []*user
 		for _, increment := range attack.increments {
-			for _, user := range increment.users {
-				go func() {
-					defer g.stop.Done()
-					user.Destroy(g.ctx)
-				}()
-			}
+			users = append(users, increment.allUsers()...)
 		}
+		g.tearDownAttack(attack, g.drain(users, attack.cancel, drainTimeout))
+	}
+
+	return nil
+}
+
+// stopIncrement terminates a specific increment. An attack left without increments stays,
+// along with its attack-level hooks and dataset rows, until it is stopped as a whole,
+// since the other nodes may still run it and the node may receive its users again.
+//
+// Parameters:
+//   - attackID: Identifier of the attack the increment belongs to
+//   - incrementID: Identifier of the increment to stop
+//   - drainTimeout: Time the running iterations are given to finish, zero cancels them right away
+//
+// Returns:
+//   - error: Any error that occurs during increment termination
+func (g *generator) stopIncrement(attackID, incrementID int64, drainTimeout time.Duration) error {
+	attack := g.attacks[attackID]
+	increment, exists := attack.increments[incrementID]
+	if !exists {
+		return core.ErrAttackNotFound
 	}
 
+	delete(attack.increments, incrementID)
+
+	// Clean up users, the teardown of the attack waits for them
+	retired := g.drain(increment.allUsers(), increment.cancel, drainTimeout)
+	attack.retiring.Add(1)
+	go func() {
+		defer attack.retiring.Done()
+		<-retired
+	}()
+
 	return nil
 }
 
+// drain retires the users of a stopped attack or increment and cancels its context.
+// The users start no new iterations, but the running ones are given the drain timeout to finish
+// before the context is cancelled. Each user is destroyed once its iteration is over.
+//
+// Parameters:
+//   - users: Users of the stopped attack or increment
+//   - cancel: Function cancelling the context of the stopped attack or increment
+//   - drainTimeout: Time the running iterations are given to finish, zero cancels them right away
+//
+// Returns:
+//   - <-chan any: Channel closed once all the users are destroyed
+func (g *generator) drain(users []*user, cancel context.CancelFunc, drainTimeout time.Duration) <-chan any {
+	for _, user := range users {
+		user.stop()
+	}
+
+	if drainTimeout <= 0 {
+		cancel()
+	}
+
+	var retired sync.WaitGroup
+	retired.Add(len(users))
+	for _, user := range users {
+		go func() {
+			defer retired.Done()
+			g.retireUser(user)
+		}()
+	}
+
+	drained := make(chan any)
+	go func() {
+		retired.Wait()
+		close(drained)
+	}()
+
+	if drainTimeout <= 0 {
+		return drained
+	}
+
+	go func() {
+		timer := time.NewTimer(drainTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-drained:
+		case <-timer.C:
+			log.Printf("drain timeout of %v expired, cancelling the running iterations", drainTimeout)
+		case <-g.ctx.Done():
+		}
+		cancel()
+	}()
+
+	return drained
+}
+
+// tearDownAttack runs the attack-level teardowns of a stopped attack once its users are destroyed,
+// including the users of its increments stopped or reduced before.
+// Only the teardowns of the scenarios whose setup the node has run are run.
+//
+// Parameters:
+//   - attack: The stopped attack
+//   - retired: Channel closed once the users of the remaining increments are destroyed
+func (g *generator) tearDownAttack(attack attack, retired <-chan any) {
+	if len(attack.hooks) == 0 {
+		return
+	}
+
+	// The context of the attack is cancelled, but the teardown keeps its values
+	ctx := context.WithoutCancel(attack.ctx)
+
+	g.stop.Add(1)
+	go func() {
+		defer g.stop.Done()
+		<-retired
+		attack.retiring.Wait()
+
+		for _, hook := range attack.hooks {
+			hook.tearDown(ctx)
+		}
+	}()
+}
+
+// ReduceAttack retires part of the users of a running increment, starting from the newest ones
+//
+// Parameters:
+//   - reduce: Operation details including the amount of users to retire per scenario
+//
+// Returns:
+//   - error: Any error that occurs during increment reduction
+func (g *generator) ReduceAttack(reduce core.OperationReduce) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	attack, exists := g.attacks[reduce.AttackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	increment, exists := attack.increments[reduce.IncrementID]
+	if !exists {
+		return core.ErrIncrementNotFound
+	}
+
+	toRetire := make(map[string]int64, len(reduce.Scenarios))
+	for name, amount := range reduce.Scenarios {
+		toRetire[name] = amount
+	}
+
+	// Retire closed model users from the end of the increment
+	var retired []*user
+	users := make([]*user, 0, len(increment.users))
+	for i := len(increment.users) - 1; i >= 0; i-- {
+		user := increment.users[i]
+		if toRetire[user.scenario.Name] > 0 {
+			toRetire[user.scenario.Name]--
+			retired = append(retired, user)
+			continue
+		}
+		users = append(users, user)
+	}
+	slices.Reverse(users)
+	increment.users = users
+
+	// Retired users no longer hold back the completion of the iteration budget
+	if increment.budget != nil {
+		for _, user := range retired {
+			increment.budget.remove(user)
+		}
+	}
+
+	// Shrink users pools of the open model scenarios
+	var poolSize int64
+	for _, executor := range increment.executors {
+		retired = append(retired, executor.shrink(toRetire[executor.scenario])...)
+		poolSize += executor.size()
+	}
+
+	attack.increments[reduce.IncrementID] = increment
+
+	attack.retiring.Add(len(retired))
+	for _, user := range retired {
+		go func() {
+			defer attack.retiring.Done()
+			g.retireUser(user)
+		}()
+	}
+
+	// Nothing is left to run - stop the whole increment
+	if len(increment.users) == 0 && poolSize == 0 {
+		return g.stopIncrement(reduce.AttackID, reduce.IncrementID, 0)
+	}
+
+	return nil
+}
+
+// RescaleAttack changes the arrival rates of the open model scenarios of a running increment.
+// The users pools keep their users, so their state and leases are preserved.
+//
+// Parameters:
+//   - rescale: Operation details including the new arrival rate per scenario
+//
+// Returns:
+//   - error: Any error that occurs during increment rescaling
+func (g *generator) RescaleAttack(rescale core.OperationRescale) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	attack, exists := g.attacks[rescale.AttackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	increment, exists := attack.increments[rescale.IncrementID]
+	if !exists {
+		return core.ErrIncrementNotFound
+	}
+
+	for _, executor := range increment.executors {
+		if rate, exists := rescale.ArrivalRates[executor.scenario]; exists {
+			executor.rescale(rate)
+		}
+	}
+
+	return nil
+}
+
+// PauseAttack suspends the iterations of an attack. Running iterations are finished,
+// but no new ones are started until the attack is resumed. The users keep their state.
+//
+// Parameters:
+//   - pause: Operation details including the attack to pause
+//
+// Returns:
+//   - error: Any error that occurs during attack pausing
+func (g *generator) PauseAttack(pause core.OperationPause) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	attack, exists := g.attacks[pause.AttackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	attack.paused.Store(true)
+
+	return nil
+}
+
+// ResumeAttack continues starting iterations of a paused attack
+//
+// Parameters:
+//   - resume: Operation details including the attack to resume
+//
+// Returns:
+//   - error: Any error that occurs during attack resuming
+func (g *generator) ResumeAttack(resume core.OperationResume) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	attack, exists := g.attacks[resume.AttackID]
+	if !exists {
+		return core.ErrAttackNotFound
+	}
+
+	attack.paused.Store(false)
+
+	return nil
+}
+
+// complete reports the completion of an iteration-bounded increment without blocking its users.
+//
+// Parameters:
+//   - completion: The completion to report
+func (g *generator) complete(completion core.IncrementCompletion) {
+	go func() {
+		select {
+		case g.completions <- completion:
+		case <-g.ctx.Done():
+		}
+	}()
+}
+
+// Completions returns the channel receiving the completions of the iteration-bounded increments
+//
+// Returns:
+//   - <-chan core.IncrementCompletion: Channel of the completions
+func (g *generator) Completions() <-chan core.IncrementCompletion {
+	return g.completions
+}
+
+// retireUser destroys a user that is no longer part of any increment
+//
+// Parameters:
+//   - u: The user to destroy
+func (g *generator) retireUser(u *user) {
+	defer g.stop.Done()
+	u.Destroy(g.ctx)
+}
+
 // Stop gracefully shuts down the generator, terminating all active attacks
 func (g *generator) Stop() {
 	ctx := context.Background()
@@ -250,16 +713,27 @@ func (g *generator) Stop() {
 		log.Printf("error shutdowning scheduler: %v", err)
 	}
 
-	// Clean up all users in all attacks
+	// Clean up all users in all attacks, then tear the attacks down
 	for _, attack := range g.attacks {
+		var destroyed sync.WaitGroup
 		for _, increment := range attack.increments {
-			for _, user := range increment.users {
+			for _, user := range increment.allUsers() {
+				user.stop()
+				destroyed.Add(1)
 				go func() {
 					defer g.stop.Done()
+					defer destroyed.Done()
 					user.Destroy(ctx)
 				}()
 			}
 		}
+
+		retired := make(chan any)
+		go func() {
+			destroyed.Wait()
+			close(retired)
+		}()
+		g.tearDownAttack(attack, retired)
 	}
 
 	g.stop.Wait()
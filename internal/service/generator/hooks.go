@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"load-generation-system/internal/core"
+	"load-generation-system/internal/metrics"
+	"load-generation-system/internal/scenarios"
+	"load-generation-system/internal/service/callers"
+	"log"
+	"sync"
+	"time"
+)
+
+// setupReportTimeout bounds the report of the outcome of an attack-level setup to the manager.
+const setupReportTimeout = 5 * time.Second
+
+// Bounds of the interval between the claims of an attack-level setup failing for a transient reason,
+// such as the manager being unreachable. The interval doubles after every failure.
+const (
+	minClaimRetryInterval = 100 * time.Millisecond
+	maxClaimRetryInterval = 5 * time.Second
+)
+
+// attackHook runs the attack-level setup and teardown of a scenario on the node.
+// The setup is claimed on the manager by the first user of the scenario about to iterate,
+// the users of the scenario wait for its outcome, whichever node runs it.
+type attackHook struct {
+	attackID    int64                 // ID of the attack
+	scenario    scenarios.Scenario    // The scenario of the hooks
+	caller      *callers.Caller       // Caller of the hooks, its state passes from the setup to the teardown
+	coordinator core.SetupCoordinator // Client claiming the setup on the manager, nil to run it on the node
+	ctx         context.Context       // Context of the attack, bounding the setup
+
+	start sync.Once // Starts the setup once, or prevents it once the attack is over
+	done  chan any  // Closed once the setup is over
+	err   error     // Setup error, set before done is closed
+	owner bool      // Whether the node has run the setup, set before done is closed
+}
+
+func newAttackHook(
+	ctx context.Context,
+	attackID int64,
+	scenario scenarios.Scenario,
+	caller *callers.Caller,
+	coordinator core.SetupCoordinator,
+) *attackHook {
+	return &attackHook{
+		attackID:    attackID,
+		scenario:    scenario,
+		caller:      caller,
+		coordinator: coordinator,
+		ctx:         ctx,
+		done:        make(chan any),
+	}
+}
+
+// wait starts the attack-level setup if it has not started yet and waits until it is over.
+//
+// Parameters:
+//   - ctx: The context of the waiting user
+//
+// Returns:
+//   - error: The setup error, the context error if the context is done first
+func (h *attackHook) wait(ctx context.Context) error {
+	h.start.Do(func() {
+		go h.setUp()
+	})
+
+	select {
+	case <-h.done:
+		return h.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// setUp claims the attack-level setup on the manager and runs it if the node is the first to claim it,
+// otherwise waits for its outcome on the node running it.
+func (h *attackHook) setUp() {
+	defer close(h.done)
+
+	run, err := h.claim()
+	if err != nil {
+		if h.ctx.Err() == nil {
+			log.Printf("error with attack setup scenario (attack: %d, scenario: %s): %v", h.attackID, h.scenario.Name, err)
+		}
+		h.err = err
+		return
+	}
+	if !run {
+		return
+	}
+	h.owner = true
+
+	if h.scenario.AttackSetup != nil {
+		start := time.Now()
+		h.err = h.scenario.AttackSetup(metrics.WithHook(h.ctx, metrics.HookAttackSetup), h.caller)
+		metrics.ObserveHook(h.ctx, h.scenario.Name, metrics.HookAttackSetup, time.Since(start), h.err != nil)
+		if h.err != nil {
+			log.Printf("error with attack setup scenario (attack: %d, scenario: %s): %v", h.attackID, h.scenario.Name, h.err)
+		}
+	}
+
+	// Release the users waiting on the other nodes
+	if h.coordinator != nil {
+		reportCtx, cancel := context.WithTimeout(context.WithoutCancel(h.ctx), setupReportTimeout)
+		defer cancel()
+		if err := h.coordinator.Complete(reportCtx, h.attackID, h.scenario.Name, h.err); err != nil && !errors.Is(err, core.ErrAttackNotFound) {
+			log.Printf("error with reporting attack setup (attack: %d, scenario: %s): %v", h.attackID, h.scenario.Name, err)
+		}
+	}
+}
+
+// claim claims the attack-level setup on the manager, retrying the claims failing for a transient reason.
+//
+// Returns:
+//   - bool: true if the node must run the setup, always true without a coordinator
+//   - error: core.ErrSetupFailed if the setup has failed on another node, core.ErrAttackNotFound if the attack
+//     has ended, the context error if the attack context is done first
+func (h *attackHook) claim() (bool, error) {
+	if h.coordinator == nil {
+		return true, nil
+	}
+
+	interval := minClaimRetryInterval
+	for {
+		run, err := h.coordinator.Claim(h.ctx, h.attackID, h.scenario.Name)
+		if err == nil || errors.Is(err, core.ErrSetupFailed) || errors.Is(err, core.ErrAttackNotFound) || h.ctx.Err() != nil {
+			return run, err
+		}
+		log.Printf("error with claiming attack setup, retrying in %s (attack: %d, scenario: %s): %v", interval, h.attackID, h.scenario.Name, err)
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-h.ctx.Done():
+			timer.Stop()
+			return false, h.ctx.Err()
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxClaimRetryInterval)
+	}
+}
+
+// tearDown runs the attack-level teardown once the attack is over, if the node has run the setup successfully.
+// A setup not started yet is never started afterwards.
+//
+// Parameters:
+//   - ctx: The context used for the teardown
+func (h *attackHook) tearDown(ctx context.Context) {
+	h.start.Do(func() {
+		close(h.done)
+	})
+	<-h.done
+
+	if !h.owner || h.err != nil {
+		return
+	}
+
+	if h.scenario.AttackTeardown != nil {
+		start := time.Now()
+		err := h.scenario.AttackTeardown(metrics.WithHook(ctx, metrics.HookAttackTeardown), h.caller)
+		metrics.ObserveHook(ctx, h.scenario.Name, metrics.HookAttackTeardown, time.Since(start), err != nil)
+		if err != nil {
+			log.Printf("error with attack teardown scenario (attack: %d, scenario: %s): %v", h.attackID, h.scenario.Name, err)
+		}
+	}
+
+	// Return the resources leased by the hooks to their pools. If an error occurs, log it.
+	releaseCtx, cancel := context.WithTimeout(ctx, leaseReleaseTimeout)
+	defer cancel()
+	if err := h.caller.ReleaseLeases(releaseCtx); err != nil {
+		log.Printf("error with releasing leases (attack: %d, scenario: %s): %v", h.attackID, h.scenario.Name, err)
+	}
+}
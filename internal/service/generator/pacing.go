@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"context"
+	"load-generation-system/internal/core"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+// runPaced runs the iterations of a user of a paced scenario on its own schedule, instead of the attack
+// scheduler tick, until the context is canceled or the user is stopped. The first iteration is delayed
+// by a random offset, so that the users of the scenario do not run in lockstep.
+//
+// Parameters:
+//   - ctx: The context of the increment the user belongs to
+//   - u: The user to run
+//   - pacing: The pacing of the user scenario
+//   - paused: Whether the attack of the user is paused
+func runPaced(ctx context.Context, u *user, pacing core.Pacing, paused *atomic.Bool) {
+	timer := time.NewTimer(initialDelay(pacing))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if u.stopped.Load() {
+			return
+		}
+		if paused.Load() {
+			timer.Reset(idleRateCheckInterval)
+			continue
+		}
+
+		started := time.Now()
+		u.Run(ctx)
+		timer.Reset(nextDelay(pacing, time.Since(started)))
+	}
+}
+
+// initialDelay computes the random offset of the first iteration of a paced user.
+//
+// Parameters:
+//   - pacing: The pacing of the user scenario
+//
+// Returns:
+//   - time.Duration: Time to wait before the first iteration
+func initialDelay(pacing core.Pacing) time.Duration {
+	switch pacing.Mode {
+	case core.PacingUniformThink:
+		return seconds(rand.Float64() * pacing.MaxSec)
+	case core.PacingExponentialThink, core.PacingPoisson:
+		return seconds(rand.ExpFloat64() * pacing.IntervalSec)
+	default:
+		return seconds(rand.Float64() * pacing.IntervalSec)
+	}
+}
+
+// nextDelay computes the time between the end of an iteration and the start of the next one.
+//
+// Parameters:
+//   - pacing: The pacing of the user scenario
+//   - elapsed: Duration of the finished iteration
+//
+// Returns:
+//   - time.Duration: Time to wait before the next iteration
+//
+// Fixed and Poisson pacings measure the interval from the start of the iteration, so an iteration
+// outlasting its interval is followed by the next one right away.
+func nextDelay(pacing core.Pacing, elapsed time.Duration) time.Duration {
+	switch pacing.Mode {
+	case core.PacingUniformThink:
+		return seconds(pacing.MinSec + rand.Float64()*(pacing.MaxSec-pacing.MinSec))
+	case core.PacingExponentialThink:
+		return seconds(rand.ExpFloat64() * pacing.IntervalSec)
+	case core.PacingPoisson:
+		return max(seconds(rand.ExpFloat64()*pacing.IntervalSec)-elapsed, 0)
+	default:
+		return max(seconds(pacing.IntervalSec)-elapsed, 0)
+	}
+}
+
+// seconds converts fractional seconds to a duration.
+func seconds(sec float64) time.Duration {
+	return time.Duration(sec * float64(time.Second))
+}
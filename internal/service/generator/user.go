@@ -2,11 +2,14 @@ package generator
 
 import (
 	"context"
+	"load-generation-system/internal/core"
 	"load-generation-system/internal/metrics"
 	"load-generation-system/internal/scenarios"
 	"load-generation-system/internal/service/callers"
 	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // user represents a virtual user that runs a scenario in a load generation system.
@@ -15,18 +18,31 @@ type user struct {
 	name     string             // The name of the user.
 	scenario scenarios.Scenario // The scenario this user is running.
 	caller   *callers.Caller    // The caller used to make requests in the scenario.
+	budget   *iterationBudget   // The iteration budget of the user increment, nil if not bounded.
+	hook     *attackHook        // The attack-level hooks of the scenario, nil if the scenario has none.
+	paced    bool               // Whether the user runs its iterations on its own pacing schedule.
+	ready    bool               // Whether the setup of the user has succeeded, guarded by mu.
+	stopped  atomic.Bool        // Whether the user must not start new iterations.
 	mu       sync.Mutex         // Mutex to synchronize access to the user.
+
+	started   int64 // Number of iterations started by the user, guarded by the budget.
+	completed int64 // Number of iterations completed by the user, guarded by the budget.
+	retired   bool  // Whether the user has been taken out of the budget, guarded by the budget.
 }
 
 func newUser(
 	name string,
 	scenario scenarios.Scenario,
 	caller *callers.Caller,
+	budget *iterationBudget,
+	hook *attackHook,
 ) *user {
 	return &user{
 		name:     name,
 		scenario: scenario,
 		caller:   caller,
+		budget:   budget,
+		hook:     hook,
 	}
 }
 
@@ -37,6 +53,9 @@ func newUser(
 //   - ctx: The context used for managing the lifecycle of the request.
 //
 // This method increments the active users gauge, executes the scenario, and then decrements the active users gauge.
+// Users of an iteration-bounded increment stop running the scenario once their budget is spent.
+// The first iteration waits for the attack-level setup of the scenario and runs the setup of the user,
+// neither of them counting as an iteration.
 func (u *user) Run(ctx context.Context) {
 	// Attempt to acquire a lock for this user to prevent concurrent execution.
 	if !u.mu.TryLock() {
@@ -44,6 +63,31 @@ func (u *user) Run(ctx context.Context) {
 	}
 	defer u.mu.Unlock() // Ensure the lock is released after the execution is done.
 
+	// Skip if the user is being retired.
+	if u.stopped.Load() {
+		return
+	}
+
+	// Wait for the attack-level setup of the scenario. The users do not run if it has failed.
+	if u.hook != nil {
+		if err := u.hook.wait(ctx); err != nil {
+			return
+		}
+	}
+
+	// Skip until the setup of the user succeeds.
+	if !u.setUp(ctx) {
+		return
+	}
+
+	// Skip if the iteration budget of the user is spent.
+	if u.budget != nil {
+		if !u.budget.reserve(u) {
+			return
+		}
+		defer u.budget.complete(u)
+	}
+
 	// Increment the ActiveUsersGauge metric to track the number of active users.
 	metrics.ActiveUsersGauge.Inc()
 	defer metrics.ActiveUsersGauge.Dec() // Decrement the metric once the user is done.
@@ -54,15 +98,71 @@ func (u *user) Run(ctx context.Context) {
 	}
 }
 
-// Destroy is a method to destroy the user, allowing for custom logic to be added for cleanup.
+// setUp runs the setup of the scenario for the user if it has not succeeded yet.
+// A failed setup is retried before the next iteration.
+//
+// Parameters:
+//   - ctx: The context used for the setup.
+//
+// Returns:
+//   - bool: true once the user is set up.
+//
+// Must be called with u.mu held.
+func (u *user) setUp(ctx context.Context) bool {
+	if u.ready || u.scenario.Setup == nil {
+		return true
+	}
+
+	start := time.Now()
+	err := u.scenario.Setup(metrics.WithHook(ctx, metrics.HookSetup), u.caller)
+	metrics.ObserveHook(ctx, u.scenario.Name, metrics.HookSetup, time.Since(start), err != nil)
+	if err != nil {
+		log.Printf("error with setup scenario (user: %s, scenario: %s): %v", u.name, u.scenario.Name, err)
+		return false
+	}
+	u.ready = true
+
+	return true
+}
+
+// stop prevents the user from starting new iterations. The running iteration is not interrupted.
+func (u *user) stop() {
+	u.stopped.Store(true)
+}
+
+// leaseReleaseTimeout bounds the release of the leases of a destroyed user. The leases not released
+// in time are returned to their pools by the manager once the node is given up.
+const leaseReleaseTimeout = 5 * time.Second
+
+// Destroy is a method to destroy the user once its running iteration is over.
+// It stops the user, runs the teardown of its scenario if the user is set up, releases its leases and drops the user state.
 // It uses a lock to ensure that no other actions can happen during the destroy process.
 //
 // Parameters:
 //   - ctx: The context used for the destruction process.
 func (u *user) Destroy(ctx context.Context) {
-	// Acquire a lock to ensure the destroy process is synchronized.
+	u.stop()
+
+	// Acquire a lock to wait for the running iteration and keep new ones from starting.
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
-	// Implement any custom destroy logic here. Currently, there is no additional logic.
+	// Run the per-user teardown of the scenario. If an error occurs, log it.
+	if u.scenario.Teardown != nil && (u.ready || u.scenario.Setup == nil) {
+		start := time.Now()
+		err := u.scenario.Teardown(metrics.WithHook(ctx, metrics.HookTeardown), u.caller)
+		metrics.ObserveHook(ctx, u.scenario.Name, metrics.HookTeardown, time.Since(start), err != nil)
+		if err != nil {
+			log.Printf("error with teardown scenario (user: %s, scenario: %s): %v", u.name, u.scenario.Name, err)
+		}
+	}
+
+	// Return the leased resources to their pools. If an error occurs, log it.
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), leaseReleaseTimeout)
+	defer cancel()
+	if err := u.caller.ReleaseLeases(releaseCtx); err != nil {
+		log.Printf("error with releasing leases (user: %s, scenario: %s): %v", u.name, u.scenario.Name, err)
+	}
+
+	u.caller.State = core.State{}
 }
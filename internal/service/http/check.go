@@ -0,0 +1,123 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"load-generation-system/internal/core"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// ValidateCheck checks that a check can be run.
+//
+// Parameters:
+//   - check: The check to validate
+//
+// Returns:
+//   - error: core.ErrCheckFailed describing the problem
+func ValidateCheck(check core.Check) error {
+	bad := func(format string, a ...any) error {
+		return fmt.Errorf("%w: %s: %s", core.ErrCheckFailed, check.Name, fmt.Sprintf(format, a...))
+	}
+
+	if check.Name == "" {
+		return fmt.Errorf("%w: check has no name", core.ErrCheckFailed)
+	}
+	switch check.Mode {
+	case "", core.CheckFail, core.CheckRecord:
+	default:
+		return bad("unknown mode %q", check.Mode)
+	}
+	for _, status := range check.Statuses {
+		if status < 100 || status > 599 {
+			return bad("bad status code %d", status)
+		}
+	}
+	if check.Equals != nil && check.JSONPath == "" {
+		return bad("compares without a path")
+	}
+	if check.JSONPath != "" {
+		if _, err := parseJSONPath(check.JSONPath); err != nil {
+			return bad("%v", err)
+		}
+	}
+	if len(check.JSONSchema) != 0 {
+		if _, err := compileSchema(check.JSONSchema); err != nil {
+			return bad("%v", err)
+		}
+	}
+	if check.MaxLatency < 0 {
+		return bad("negative latency budget")
+	}
+
+	return nil
+}
+
+// runCheck runs a check on a response.
+//
+// Parameters:
+//   - resp: The response to check
+//   - latency: Time from sending the request to reading the whole response
+//   - check: The check to run
+//
+// Returns:
+//   - error: core.ErrCheckFailed describing the first assertion that does not hold, or why the check is invalid
+func runCheck(resp *httpResponse, latency time.Duration, check core.Check) error {
+	if err := ValidateCheck(check); err != nil {
+		return err
+	}
+	failed := func(format string, a ...any) error {
+		return fmt.Errorf("%w: %s: %s", core.ErrCheckFailed, check.Name, fmt.Sprintf(format, a...))
+	}
+
+	if len(check.Statuses) != 0 && !slices.Contains(check.Statuses, resp.status) {
+		return failed("status %d is not one of %v", resp.status, check.Statuses)
+	}
+	if check.BodyContains != "" && !bytes.Contains(resp.body, []byte(check.BodyContains)) {
+		return failed("body does not contain %q", check.BodyContains)
+	}
+	if check.MaxLatency > 0 && latency > check.MaxLatency {
+		return failed("latency %v is above %v", latency.Round(time.Millisecond), check.MaxLatency)
+	}
+
+	if check.JSONPath == "" && len(check.JSONSchema) == 0 {
+		return nil
+	}
+	var document any
+	if err := json.Unmarshal(resp.body, &document); err != nil {
+		return failed("body is not JSON")
+	}
+
+	if check.JSONPath != "" {
+		segments, _ := parseJSONPath(check.JSONPath)
+		value, found := lookupJSONPath(document, segments)
+		if !found {
+			return failed("%s not found", check.JSONPath)
+		}
+		if check.Equals != nil && value != *check.Equals {
+			return failed("%s is %q, not %q", check.JSONPath, value, *check.Equals)
+		}
+	}
+	if len(check.JSONSchema) != 0 {
+		compiled, _ := compileSchema(check.JSONSchema)
+		if err := compiled.validate(document, "$"); err != nil {
+			return failed("%v", err)
+		}
+	}
+
+	return nil
+}
+
+// acceptableStatus checks whether the status code of a response is accepted by default,
+// when the request has no check on it.
+//
+// Parameters:
+//   - status: The status code of the response
+//
+// Returns:
+//   - bool: true for the statuses from 200 to 399
+func acceptableStatus(status int) bool {
+	return status >= http.StatusOK && status < http.StatusBadRequest
+}
@@ -16,7 +16,8 @@ import (
 
 // httpClient represents an HTTP client with an underlying HTTP client instance.
 type httpClient struct {
-	client *http.Client
+	client  *http.Client
+	headers map[string]string // Headers set on every request of the client.
 }
 
 // roundTripper wraps the http.RoundTripper interface and allows customization of request/response handling.
@@ -30,8 +31,18 @@ var uuidPattern = regexp.MustCompile(`[a-f0-9\-]{8,}`)
 // Constants used for request timeout status.
 const timeoutStatus = "Timeout"
 
+// NewClient creates an HTTP client tracking the metrics of its requests.
+//
+// Parameters:
+//   - minIdleConnTimeoutSec: Lower bound of the random idle connection timeout (in seconds)
+//   - maxIdleConnTimeoutSec: Upper bound of the random idle connection timeout (in seconds)
+//   - headers: Default headers set on every request of the client, may be nil
+//
+// Returns:
+//   - core.Client: The configured client
 func NewClient(
 	minIdleConnTimeoutSec, maxIdleConnTimeoutSec int64,
+	headers map[string]string,
 ) core.Client {
 	// Generate a random idle connection timeout within the given range.
 	randomIdleConnTimeout := time.Duration(
@@ -62,7 +73,7 @@ func NewClient(
 		},
 	}
 
-	return &httpClient{client: client}
+	return &httpClient{client: client, headers: headers}
 }
 
 // R creates and returns a new core.Request instance for making HTTP requests.
@@ -74,6 +85,10 @@ func (c *httpClient) R() core.Request {
 		// Panic in case of an error while creating the request.
 		panic(fmt.Sprintf("cannot create new request: %v", err))
 	}
+	// Apply the default headers, the request may override them.
+	for header, value := range c.headers {
+		req.Header.Set(header, value)
+	}
 	// Return a new instance of httpRequest with initialized fields.
 	return &httpRequest{
 		req:         req,
@@ -98,6 +113,7 @@ func normalizePath(path string) string {
 
 // RoundTrip implements the RoundTripper interface, which intercepts the HTTP request,
 // tracks metrics, processes the response, and returns it. It also handles errors and timeouts.
+// Requests made by the lifecycle hooks of the scenarios are not tracked, as they are not part of the load.
 //
 // Parameters:
 //   - req: The HTTP request to be sent.
@@ -106,6 +122,10 @@ func normalizePath(path string) string {
 //   - *http.Response: The HTTP response received.
 //   - error: Any error encountered during the request/response cycle.
 func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if _, ok := metrics.HookFromContext(req.Context()); ok {
+		return rt.Transport.RoundTrip(req)
+	}
+
 	// Retrieve or construct the metric path for tracking.
 	metricPath, ok := req.Context().Value(metricPath).(string)
 	if !ok {
@@ -125,6 +145,12 @@ func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	resp, err := rt.Transport.RoundTrip(req)
 	duration := time.Since(start).Seconds()
 
+	// Record the request in the statistics of its attack.
+	if attackID, ok := metrics.AttackFromContext(req.Context()); ok {
+		failed := err != nil || resp.StatusCode >= http.StatusBadRequest
+		metrics.AttackStats.Observe(attackID, duration, failed)
+	}
+
 	if err != nil {
 		// Check if the error is related to timeout or deadline exceeded.
 		isDeadline := errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
@@ -0,0 +1,217 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"load-generation-system/internal/core"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// regexps caches the compiled regular expressions of the extractors, as the same extractors
+// run on every iteration of the users.
+var regexps sync.Map
+
+// ValidateExtractor checks that an extractor can be run.
+//
+// Parameters:
+//   - extractor: The extractor to check
+//
+// Returns:
+//   - error: core.ErrExtractionFailed describing the problem
+func ValidateExtractor(extractor core.Extractor) error {
+	if extractor.Name == "" {
+		return fmt.Errorf("%w: extractor has no name", core.ErrExtractionFailed)
+	}
+	if extractor.Expression == "" {
+		return fmt.Errorf("%w: %s: expression is required", core.ErrExtractionFailed, extractor.Name)
+	}
+
+	var err error
+	switch extractor.Kind {
+	case core.ExtractorJSONPath:
+		_, err = parseJSONPath(extractor.Expression)
+	case core.ExtractorRegex:
+		_, err = compileRegex(extractor.Expression)
+	case core.ExtractorHeader, core.ExtractorCookie:
+	default:
+		err = fmt.Errorf("unknown kind %q", extractor.Kind)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", core.ErrExtractionFailed, extractor.Name, err)
+	}
+
+	return nil
+}
+
+// extract runs an extractor on a response.
+//
+// Parameters:
+//   - resp: The response to take the value out of
+//   - extractor: The extractor to run
+//
+// Returns:
+//   - string: The extracted value
+//   - error: core.ErrExtractionFailed if the extractor is invalid or finds no value
+func extract(resp *httpResponse, extractor core.Extractor) (string, error) {
+	if err := ValidateExtractor(extractor); err != nil {
+		return "", err
+	}
+
+	var value string
+	var found bool
+	switch extractor.Kind {
+	case core.ExtractorJSONPath:
+		var document any
+		if err := json.Unmarshal(resp.body, &document); err != nil {
+			return "", fmt.Errorf("%w: %s: body is not JSON", core.ErrExtractionFailed, extractor.Name)
+		}
+		segments, _ := parseJSONPath(extractor.Expression)
+		value, found = lookupJSONPath(document, segments)
+	case core.ExtractorRegex:
+		expression, _ := compileRegex(extractor.Expression)
+		if match := expression.FindSubmatch(resp.body); match != nil {
+			value, found = string(match[min(1, len(match)-1)]), true
+		}
+	case core.ExtractorHeader:
+		values := resp.header.Values(extractor.Expression)
+		if len(values) != 0 {
+			value, found = values[0], true
+		}
+	case core.ExtractorCookie:
+		value, found = resp.Cookie(extractor.Expression)
+	}
+	if !found {
+		return "", fmt.Errorf("%w: %s: %s %s not found", core.ErrExtractionFailed, extractor.Name, extractor.Kind, extractor.Expression)
+	}
+
+	return value, nil
+}
+
+// compileRegex compiles the regular expression of an extractor, once for all the users.
+//
+// Parameters:
+//   - expression: The regular expression
+//
+// Returns:
+//   - *regexp.Regexp: The compiled regular expression
+//   - error: The compilation error
+func compileRegex(expression string) (*regexp.Regexp, error) {
+	if compiled, exists := regexps.Load(expression); exists {
+		return compiled.(*regexp.Regexp), nil
+	}
+
+	compiled, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	regexps.Store(expression, compiled)
+
+	return compiled, nil
+}
+
+// parseJSONPath splits a JSONPath expression into its segments: child names and array indexes.
+//
+// Parameters:
+//   - path: The JSONPath expression, such as $.data.items[0]['id']
+//
+// Returns:
+//   - []any: Segments of the path, string for the child names and int for the array indexes
+//   - error: The error if the expression is malformed or uses unsupported features
+func parseJSONPath(path string) ([]any, error) {
+	rest, rooted := strings.CutPrefix(path, "$")
+	if !rooted {
+		return nil, fmt.Errorf("path %s does not start with $", path)
+	}
+
+	var segments []any
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 || rest[:end] == "*" {
+				return nil, fmt.Errorf("path %s has an unsupported child", path)
+			}
+			segments = append(segments, rest[:end])
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("path %s has an unclosed bracket", path)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				segments = append(segments, inner[1:len(inner)-1])
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("path %s has an unsupported selector [%s]", path, inner)
+			}
+			segments = append(segments, index)
+		default:
+			return nil, fmt.Errorf("path %s is malformed", path)
+		}
+	}
+
+	return segments, nil
+}
+
+// lookupJSONPath finds a value in a decoded JSON document.
+//
+// Parameters:
+//   - document: The decoded JSON document
+//   - segments: Segments of the path of the value
+//
+// Returns:
+//   - string: The found value, strings as they are and other values in their JSON form
+//   - bool: false if the path does not exist in the document
+func lookupJSONPath(document any, segments []any) (string, bool) {
+	value := document
+	for _, segment := range segments {
+		switch node := value.(type) {
+		case map[string]any:
+			name, ok := segment.(string)
+			if !ok {
+				name = strconv.Itoa(segment.(int))
+			}
+			item, exists := node[name]
+			if !exists {
+				return "", false
+			}
+			value = item
+		case []any:
+			index, ok := segment.(int)
+			if !ok {
+				return "", false
+			}
+			if index < 0 {
+				index += len(node)
+			}
+			if index < 0 || index >= len(node) {
+				return "", false
+			}
+			value = node[index]
+		default:
+			return "", false
+		}
+	}
+
+	if text, ok := value.(string); ok {
+		return text, true
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+
+	return string(encoded), true
+}
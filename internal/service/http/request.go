@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"io"
 	"load-generation-system/internal/core"
+	"load-generation-system/internal/metrics"
+	"maps"
 	"net/http"
 	"net/url"
-	"strings"
+	"time"
 )
 
 // contextKey defines a custom key type for storing values in the context.
@@ -27,6 +29,11 @@ type httpRequest struct {
 	queryParams  map[string][]string // Query parameters to be added to the URL.
 	pathTemplate string              // Template for the URL path.
 	pathParams   []any               // Parameters to replace placeholders in the URL path template.
+	body         []byte              // JSON body of the request, nil if it has none.
+	formData     map[string]string   // Form data of the request, nil if it has none.
+	state        *core.State         // State of the user the request is rendered with, nil if not bound.
+	extractors   []core.Extractor    // Extractors run on the response.
+	checks       []core.Check        // Checks run on the response.
 }
 
 // SetAuthToken sets the Authorization header with the given token.
@@ -53,11 +60,8 @@ func (r *httpRequest) SetBody(body any) core.Request {
 	if err != nil {
 		panic(fmt.Sprintf("cannot marshal body to JSON: %v", err))
 	}
-	r.req.Body = io.NopCloser(bytes.NewReader(jsonBody))
-	r.req.GetBody = func() (io.ReadCloser, error) {
-		return io.NopCloser(bytes.NewReader(jsonBody)), nil
-	}
-	r.req.ContentLength = int64(len(jsonBody))
+	r.body = jsonBody
+	r.formData = nil
 	r.req.Header.Set("Content-Type", "application/json")
 
 	return r
@@ -71,15 +75,8 @@ func (r *httpRequest) SetBody(body any) core.Request {
 // Returns:
 //   - *httpRequest: The current instance of the request.
 func (r *httpRequest) SetFormData(formData map[string]string) core.Request {
-	form := url.Values{}
-	for key, value := range formData {
-		form.Add(key, value)
-	}
-	r.req.Body = io.NopCloser(strings.NewReader(form.Encode()))
-	r.req.GetBody = func() (io.ReadCloser, error) {
-		return io.NopCloser(strings.NewReader(form.Encode())), nil
-	}
-	r.req.ContentLength = int64(len(form.Encode()))
+	r.formData = maps.Clone(formData)
+	r.body = nil
 	r.req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	return r
@@ -124,6 +121,43 @@ func (r *httpRequest) SetPath(template string, a ...any) core.Request {
 	return r
 }
 
+// SetState binds the request to the state of a user, rendering its templates with the user parameters
+// and storing the extracted values in them.
+//
+// Parameters:
+//   - state: The state of the user.
+//
+// Returns:
+//   - *httpRequest: The current instance of the request.
+func (r *httpRequest) SetState(state *core.State) core.Request {
+	r.state = state
+	return r
+}
+
+// Extract adds extractors run on the response of the request.
+//
+// Parameters:
+//   - extractors: The extractors to run on the response.
+//
+// Returns:
+//   - *httpRequest: The current instance of the request.
+func (r *httpRequest) Extract(extractors ...core.Extractor) core.Request {
+	r.extractors = append(r.extractors, extractors...)
+	return r
+}
+
+// Check adds checks run on the response of the request.
+//
+// Parameters:
+//   - checks: The checks to run on the response.
+//
+// Returns:
+//   - *httpRequest: The current instance of the request.
+func (r *httpRequest) Check(checks ...core.Check) core.Request {
+	r.checks = append(r.checks, checks...)
+	return r
+}
+
 // Get sends a GET request and returns the response.
 //
 // Parameters:
@@ -203,6 +237,15 @@ func (r *httpRequest) doRequest(ctx context.Context, method string) (core.Respon
 	// Format the URL using the path template and path parameters.
 	urlString := fmt.Sprintf(r.pathTemplate, r.pathParams...)
 
+	// Render the templates of a request bound to a user state. The metric path keeps the references.
+	if r.state != nil {
+		var err error
+		if urlString, err = r.render(urlString); err != nil {
+			return nil, err
+		}
+	}
+	r.setBody()
+
 	// Parse the formatted URL string.
 	parsedURL, err := url.Parse(urlString)
 	if err != nil {
@@ -222,6 +265,7 @@ func (r *httpRequest) doRequest(ctx context.Context, method string) (core.Respon
 	}
 
 	// Perform the HTTP request using the httpClient.
+	start := time.Now()
 	resp, err := r.httpClient.client.Do(r.req)
 	if err != nil {
 		if urlErr, ok := err.(*url.Error); ok {
@@ -239,12 +283,128 @@ func (r *httpRequest) doRequest(ctx context.Context, method string) (core.Respon
 	if err != nil {
 		return nil, err
 	}
+	latency := time.Since(start)
 
-	// Ensure that the status code is within the acceptable range.
-	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+	response := &httpResponse{
+		body:   bodyBytes,
+		status: resp.StatusCode,
+		header: resp.Header,
+	}
+
+	// Run the checks, recording all of their results before failing on the first one in the fail mode.
+	var checkErr error
+	statusChecked := false
+	for _, check := range r.checks {
+		err := runCheck(response, latency, check)
+		metrics.ObserveCheck(ctx, check.Name, err == nil)
+		if err != nil && check.Mode != core.CheckRecord && checkErr == nil {
+			checkErr = err
+		}
+		// Only failing status checks replace the default range, recorded ones don't decide on success.
+		statusChecked = statusChecked || (len(check.Statuses) != 0 && check.Mode != core.CheckRecord)
+	}
+	if checkErr != nil {
+		return nil, checkErr
+	}
+
+	// Ensure that the status code is within the acceptable range, unless failing status checks decide on it.
+	if !statusChecked && !acceptableStatus(resp.StatusCode) {
 		return nil, core.ErrUnacceptableCode
 	}
 
+	// Take the values out of the response, storing them in the parameters of the user.
+	if len(r.extractors) != 0 {
+		values, err := response.Extract(r.extractors...)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.state != nil {
+			if r.state.Params == nil {
+				r.state.Params = make(map[string]any, len(values))
+			}
+			for name, value := range values {
+				r.state.Params[name] = value
+			}
+		}
+	}
+
 	// Return the response body as a core.Response.
-	return &httpResponse{body: bodyBytes}, nil
+	return response, nil
+}
+
+// render replaces the references to the user parameters in the URL, headers, query parameters and body
+// of the request with their values. Values inserted into the JSON body are escaped as JSON strings,
+// as the references of a marshaled body can only be found in its strings.
+//
+// Parameters:
+//   - urlString: The formatted URL of the request.
+//
+// Returns:
+//   - string: The rendered URL.
+//   - error: core.ErrUnknownVariable if a referenced parameter is not set.
+func (r *httpRequest) render(urlString string) (string, error) {
+	params := r.state.Params
+
+	urlString, err := render(urlString, params, nil)
+	if err != nil {
+		return "", err
+	}
+
+	for _, values := range r.req.Header {
+		for i, value := range values {
+			if values[i], err = render(value, params, nil); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	queryParams := make(map[string][]string, len(r.queryParams))
+	for key, values := range r.queryParams {
+		rendered := make([]string, len(values))
+		for i, value := range values {
+			if rendered[i], err = render(value, params, nil); err != nil {
+				return "", err
+			}
+		}
+		queryParams[key] = rendered
+	}
+	r.queryParams = queryParams
+
+	for key, value := range r.formData {
+		if r.formData[key], err = render(value, params, nil); err != nil {
+			return "", err
+		}
+	}
+
+	if r.body != nil {
+		body, err := render(string(r.body), params, escapeJSON)
+		if err != nil {
+			return "", err
+		}
+		r.body = []byte(body)
+	}
+
+	return urlString, nil
+}
+
+// setBody sets the JSON body or the encoded form data of the request as its body.
+func (r *httpRequest) setBody() {
+	content := r.body
+	if r.formData != nil {
+		form := url.Values{}
+		for key, value := range r.formData {
+			form.Add(key, value)
+		}
+		content = []byte(form.Encode())
+	}
+	if content == nil {
+		return
+	}
+
+	r.req.Body = io.NopCloser(bytes.NewReader(content))
+	r.req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	r.req.ContentLength = int64(len(content))
 }
@@ -1,9 +1,56 @@
 package http
 
+import (
+	"load-generation-system/internal/core"
+	"net/http"
+)
+
 type httpResponse struct {
-	body []byte
+	body   []byte
+	status int
+	header http.Header
 }
 
 func (r *httpResponse) Body() []byte {
 	return r.body
 }
+
+func (r *httpResponse) StatusCode() int {
+	return r.status
+}
+
+func (r *httpResponse) Header(header string) string {
+	return r.header.Get(header)
+}
+
+func (r *httpResponse) Cookie(name string) (string, bool) {
+	cookies := (&http.Response{Header: r.header}).Cookies()
+	for _, cookie := range cookies {
+		if cookie.Name == name {
+			return cookie.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Extract takes values out of the response.
+//
+// Parameters:
+//   - extractors: The extractors to run on the response
+//
+// Returns:
+//   - map[string]string: The extracted values indexed by extractor name
+//   - error: core.ErrExtractionFailed if an extractor is invalid or finds no value
+func (r *httpResponse) Extract(extractors ...core.Extractor) (map[string]string, error) {
+	values := make(map[string]string, len(extractors))
+	for _, extractor := range extractors {
+		value, err := extract(r, extractor)
+		if err != nil {
+			return nil, err
+		}
+		values[extractor.Name] = value
+	}
+
+	return values, nil
+}
@@ -0,0 +1,243 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// schemas caches the compiled JSON schemas of the checks, as the same checks run on every iteration of the users.
+var schemas sync.Map
+
+// schema is a compiled JSON schema. The validation keywords of the common subset of the JSON schema drafts
+// are supported: type, enum, const, properties, required, additionalProperties, items, minItems, maxItems,
+// minimum, maximum, minLength, maxLength and pattern. Other keywords, such as title or $schema, are ignored.
+type schema struct {
+	Type                 schemaTypes        `json:"type"`
+	Enum                 []any              `json:"enum"`
+	Const                *any               `json:"const"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties *json.RawMessage   `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	MinItems             *int               `json:"minItems"`
+	MaxItems             *int               `json:"maxItems"`
+	Minimum              *float64           `json:"minimum"`
+	Maximum              *float64           `json:"maximum"`
+	MinLength            *int               `json:"minLength"`
+	MaxLength            *int               `json:"maxLength"`
+	Pattern              string             `json:"pattern"`
+
+	additional *schema        // Schema of the properties not listed in Properties, nil if any is allowed.
+	closed     bool           // Whether the properties not listed in Properties are forbidden.
+	pattern    *regexp.Regexp // Compiled Pattern.
+}
+
+// schemaTypes holds the types a JSON schema allows, given either as a single type or as a list.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("type must be a string or a list of strings")
+	}
+	*t = list
+
+	return nil
+}
+
+// compileSchema parses a JSON schema, once for all the users.
+//
+// Parameters:
+//   - data: The JSON schema
+//
+// Returns:
+//   - *schema: The compiled schema
+//   - error: The error if the schema is malformed
+func compileSchema(data []byte) (*schema, error) {
+	if compiled, exists := schemas.Load(string(data)); exists {
+		return compiled.(*schema), nil
+	}
+
+	var compiled schema
+	if err := json.Unmarshal(data, &compiled); err != nil {
+		return nil, fmt.Errorf("bad JSON schema: %v", err)
+	}
+	if err := compiled.compile(); err != nil {
+		return nil, fmt.Errorf("bad JSON schema: %v", err)
+	}
+	schemas.Store(string(data), &compiled)
+
+	return &compiled, nil
+}
+
+// compile checks the keywords of the schema and of its subschemas and prepares them for the validation.
+//
+// Returns:
+//   - error: The error if a keyword is invalid
+func (s *schema) compile() error {
+	for _, schemaType := range s.Type {
+		switch schemaType {
+		case "object", "array", "string", "number", "integer", "boolean", "null":
+		default:
+			return fmt.Errorf("unknown type %q", schemaType)
+		}
+	}
+
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return err
+		}
+		s.pattern = pattern
+	}
+
+	if s.AdditionalProperties != nil {
+		var allowed bool
+		if err := json.Unmarshal(*s.AdditionalProperties, &allowed); err == nil {
+			s.closed = !allowed
+		} else {
+			s.additional = &schema{}
+			if err := json.Unmarshal(*s.AdditionalProperties, s.additional); err != nil {
+				return fmt.Errorf("additionalProperties: %v", err)
+			}
+		}
+	}
+
+	subschemas := make([]*schema, 0, len(s.Properties)+2)
+	for _, property := range s.Properties {
+		subschemas = append(subschemas, property)
+	}
+	subschemas = append(subschemas, s.Items, s.additional)
+	for _, subschema := range subschemas {
+		if subschema == nil {
+			continue
+		}
+		if err := subschema.compile(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks that a decoded JSON value conforms to the schema.
+//
+// Parameters:
+//   - value: The decoded JSON value
+//   - path: JSONPath of the value, used in the error
+//
+// Returns:
+//   - error: Description of the first violation found
+func (s *schema) validate(value any, path string) error {
+	if len(s.Type) != 0 && !slices.ContainsFunc(s.Type, func(schemaType string) bool {
+		return hasType(value, schemaType)
+	}) {
+		return fmt.Errorf("%s is not of type %v", path, []string(s.Type))
+	}
+	if len(s.Enum) != 0 && !slices.ContainsFunc(s.Enum, func(allowed any) bool {
+		return reflect.DeepEqual(allowed, value)
+	}) {
+		return fmt.Errorf("%s is not one of the allowed values", path)
+	}
+	if s.Const != nil && !reflect.DeepEqual(*s.Const, value) {
+		return fmt.Errorf("%s is not the expected value", path)
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, exists := typed[name]; !exists {
+				return fmt.Errorf("%s misses the required property %s", path, name)
+			}
+		}
+		for name, item := range typed {
+			subschema, listed := s.Properties[name]
+			switch {
+			case listed:
+			case s.closed:
+				return fmt.Errorf("%s has the unexpected property %s", path, name)
+			case s.additional != nil:
+				subschema = s.additional
+			default:
+				continue
+			}
+			if err := subschema.validate(item, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if s.MinItems != nil && len(typed) < *s.MinItems {
+			return fmt.Errorf("%s has fewer than %d items", path, *s.MinItems)
+		}
+		if s.MaxItems != nil && len(typed) > *s.MaxItems {
+			return fmt.Errorf("%s has more than %d items", path, *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range typed {
+				if err := s.Items.validate(item, path+"["+strconv.Itoa(i)+"]"); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(typed)
+		if s.MinLength != nil && length < *s.MinLength {
+			return fmt.Errorf("%s is shorter than %d characters", path, *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return fmt.Errorf("%s is longer than %d characters", path, *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(typed) {
+			return fmt.Errorf("%s does not match %s", path, s.Pattern)
+		}
+	case float64:
+		if s.Minimum != nil && typed < *s.Minimum {
+			return fmt.Errorf("%s is below %v", path, *s.Minimum)
+		}
+		if s.Maximum != nil && typed > *s.Maximum {
+			return fmt.Errorf("%s is above %v", path, *s.Maximum)
+		}
+	}
+
+	return nil
+}
+
+// hasType checks whether a decoded JSON value is of a JSON schema type.
+//
+// Parameters:
+//   - value: The decoded JSON value
+//   - schemaType: The JSON schema type
+//
+// Returns:
+//   - bool: true if the value is of the type
+func hasType(value any, schemaType string) bool {
+	switch typed := value.(type) {
+	case map[string]any:
+		return schemaType == "object"
+	case []any:
+		return schemaType == "array"
+	case string:
+		return schemaType == "string"
+	case float64:
+		return schemaType == "number" || (schemaType == "integer" && typed == math.Trunc(typed))
+	case bool:
+		return schemaType == "boolean"
+	case nil:
+		return schemaType == "null"
+	}
+
+	return false
+}
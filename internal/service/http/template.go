@@ -0,0 +1,58 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"load-generation-system/internal/core"
+	"regexp"
+	"strings"
+)
+
+// placeholder matches the {{name}} references to the user parameters in the request templates.
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// render replaces the references to the user parameters in a request template with their values.
+//
+// Parameters:
+//   - template: The template to render
+//   - params: The user parameters
+//   - escape: Function escaping the values for their place in the template, nil to insert them as they are
+//
+// Returns:
+//   - string: The rendered text
+//   - error: core.ErrUnknownVariable if a referenced parameter is not set
+func render(template string, params map[string]any, escape func(string) string) (string, error) {
+	if !strings.Contains(template, "{{") {
+		return template, nil
+	}
+
+	var err error
+	rendered := placeholder.ReplaceAllStringFunc(template, func(match string) string {
+		name := placeholder.FindStringSubmatch(match)[1]
+		value, exists := params[name]
+		if !exists {
+			err = fmt.Errorf("%w: %s", core.ErrUnknownVariable, name)
+			return match
+		}
+
+		text := fmt.Sprint(value)
+		if escape != nil {
+			text = escape(text)
+		}
+		return text
+	})
+
+	return rendered, err
+}
+
+// escapeJSON escapes a value inserted into a JSON string.
+//
+// Parameters:
+//   - value: The value to escape
+//
+// Returns:
+//   - string: The escaped value, without the surrounding quotes
+func escapeJSON(value string) string {
+	encoded, _ := json.Marshal(value)
+	return string(encoded[1 : len(encoded)-1])
+}
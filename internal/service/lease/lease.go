@@ -0,0 +1,400 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+	"load-generation-system/internal/core"
+	"load-generation-system/internal/metrics"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// pool holds the state of a lease pool.
+//
+// Fields:
+//   - resources: Resources of the pool, in their configuration order
+//   - free: Resources not leased, handed out in order
+//   - leases: Leases held on the resources of the pool, indexed by lease ID
+//   - waiting: Number of users waiting for a resource
+//   - released: Closed and replaced whenever resources become free, to wake the waiting users
+//   - updatedAt: Time when the pool was created or last replaced
+type pool struct {
+	resources []string
+	free      []string
+	leases    map[string]core.Lease
+	waiting   int
+	released  chan any
+	updatedAt time.Time
+}
+
+// leaseService implements core.LeaseService and keeps the lease pools shared by the users of all the nodes.
+//
+// Fields:
+//   - pools: Lease pools indexed by name
+//   - mu: Mutex for concurrent access protection
+type leaseService struct {
+	pools map[string]*pool // Lease pools
+	mu    sync.Mutex       // Concurrency control
+}
+
+// NewService creates a new lease service instance.
+//
+// Returns:
+//   - core.LeaseService: Initialized lease service
+func NewService() core.LeaseService {
+	return &leaseService{
+		pools: make(map[string]*pool),
+	}
+}
+
+// SavePool creates a lease pool or replaces the resources of the one with the same name.
+// Leases held on resources kept in the pool stay valid, the ones on removed resources
+// stay valid until they are released.
+//
+// Parameters:
+//   - leasePool: Configuration of the pool
+//
+// Returns:
+//   - core.LeasePoolDetails: Details of the saved pool
+//   - error: core.ErrBadLeasePool if the pool has no resources or lists a resource twice
+func (s *leaseService) SavePool(leasePool core.LeasePool) (core.LeasePoolDetails, error) {
+	if len(leasePool.Resources) == 0 {
+		return core.LeasePoolDetails{}, fmt.Errorf("%w: pool %s has no resources", core.ErrBadLeasePool, leasePool.Name)
+	}
+	for i, resource := range leasePool.Resources {
+		if slices.Contains(leasePool.Resources[:i], resource) {
+			return core.LeasePoolDetails{}, fmt.Errorf("%w: resource %s is listed twice", core.ErrBadLeasePool, resource)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, exists := s.pools[leasePool.Name]
+	if !exists {
+		p = &pool{
+			leases:   make(map[string]core.Lease),
+			released: make(chan any),
+		}
+		s.pools[leasePool.Name] = p
+	}
+
+	p.resources = slices.Clone(leasePool.Resources)
+	p.free = make([]string, 0, len(p.resources))
+	for _, resource := range p.resources {
+		if !p.leased(resource) {
+			p.free = append(p.free, resource)
+		}
+	}
+	p.updatedAt = time.Now().UTC().Truncate(time.Second)
+
+	p.wake()
+	exportPool(leasePool.Name, p)
+
+	return p.details(leasePool.Name), nil
+}
+
+// GetPool retrieves a lease pool by its name.
+//
+// Parameters:
+//   - name: Name of the pool
+//
+// Returns:
+//   - core.LeasePoolDetails: Details of the pool
+//   - error: Possible errors:
+//   - core.ErrLeasePoolNotFound if the pool doesn't exist
+func (s *leaseService) GetPool(name string) (core.LeasePoolDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, exists := s.pools[name]
+	if !exists {
+		return core.LeasePoolDetails{}, core.ErrLeasePoolNotFound
+	}
+
+	return p.details(name), nil
+}
+
+// GetPools retrieves details of all lease pools.
+//
+// Returns:
+//   - []core.LeasePoolDetails: A slice containing details of all pools
+func (s *leaseService) GetPools() []core.LeasePoolDetails {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pools := make([]core.LeasePoolDetails, 0, len(s.pools))
+	for name, p := range s.pools {
+		pools = append(pools, p.details(name))
+	}
+
+	return pools
+}
+
+// DeletePool removes a lease pool along with its leases. The users waiting for one of its resources
+// give up, and releasing the leases of the pool afterwards fails with core.ErrLeaseNotFound.
+//
+// Parameters:
+//   - name: Name of the pool to delete
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrLeasePoolNotFound if the pool doesn't exist
+func (s *leaseService) DeletePool(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, exists := s.pools[name]
+	if !exists {
+		return core.ErrLeasePoolNotFound
+	}
+	delete(s.pools, name)
+
+	p.wake()
+
+	metrics.LeasePoolSizeGauge.DeleteLabelValues(name)
+	metrics.LeasedResourcesGauge.DeleteLabelValues(name)
+	metrics.LeasePoolUtilizationGauge.DeleteLabelValues(name)
+	metrics.LeaseWaitingGauge.DeleteLabelValues(name)
+	metrics.LeaseWaitHistogram.DeleteLabelValues(name)
+
+	return nil
+}
+
+// Acquire leases a free resource of a pool to a user of a node. If all the resources are leased,
+// the user waits until one of them is released or the context is done.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//   - node: Name of the node of the user
+//   - instance: ID of the node process of the user
+//   - name: Name of the pool
+//
+// Returns:
+//   - core.Lease: The granted lease
+//   - error: Possible errors:
+//   - core.ErrLeasePoolNotFound if the pool doesn't exist or is deleted during the wait
+//   - The context error if the context is done before a resource is free
+func (s *leaseService) Acquire(ctx context.Context, node, instance, name string) (core.Lease, error) {
+	waitStart := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for {
+		p, exists := s.pools[name]
+		if !exists {
+			return core.Lease{}, core.ErrLeasePoolNotFound
+		}
+
+		if len(p.free) != 0 {
+			lease := core.Lease{
+				ID:         uuid.NewString(),
+				Pool:       name,
+				Resource:   p.free[0],
+				Node:       node,
+				Instance:   instance,
+				AcquiredAt: time.Now(),
+			}
+			p.free = p.free[1:]
+			p.leases[lease.ID] = lease
+
+			exportPool(name, p)
+			metrics.LeaseWaitHistogram.WithLabelValues(name).Observe(time.Since(waitStart).Seconds())
+
+			return lease, nil
+		}
+
+		// Wait without the lock for a resource to be released
+		released := p.released
+		p.waiting++
+		exportPool(name, p)
+		s.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+		}
+
+		s.mu.Lock()
+		p.waiting--
+		if s.pools[name] == p {
+			exportPool(name, p)
+		}
+
+		if err := ctx.Err(); err != nil {
+			return core.Lease{}, err
+		}
+	}
+}
+
+// Release returns a leased resource to its pool and wakes the users waiting for one.
+// A resource removed from the pool while it was leased is dropped instead.
+//
+// Parameters:
+//   - lease: The lease to release
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrLeaseNotFound if the lease was already released or its pool deleted
+func (s *leaseService) Release(lease core.Lease) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, exists := s.pools[lease.Pool]
+	if !exists {
+		return core.ErrLeaseNotFound
+	}
+	if _, exists := p.leases[lease.ID]; !exists {
+		return core.ErrLeaseNotFound
+	}
+
+	p.release(lease.ID)
+	exportPool(lease.Pool, p)
+
+	return nil
+}
+
+// ReleaseNode returns all the resources leased to the users of a node to their pools.
+// It is used once a disconnected node is given up, as its users can no longer release them.
+//
+// Parameters:
+//   - node: Name of the node
+func (s *leaseService) ReleaseNode(node string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, p := range s.pools {
+		released := 0
+		for id, lease := range p.leases {
+			if lease.Node == node {
+				p.release(id)
+				released++
+			}
+		}
+
+		if released != 0 {
+			log.Printf("released %d leases of pool %s held by node %s", released, name, node)
+			exportPool(name, p)
+		}
+	}
+}
+
+// ReleaseStale returns the resources leased to the users of the previous processes of a node to their pools.
+// It is used once a restarted node registers again, as the users of its previous processes are gone
+// and can no longer release them.
+//
+// Parameters:
+//   - node: Name of the node
+//   - instance: ID of the current process of the node
+func (s *leaseService) ReleaseStale(node, instance string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, p := range s.pools {
+		released := 0
+		for id, lease := range p.leases {
+			if lease.Node == node && lease.Instance != instance {
+				p.release(id)
+				released++
+			}
+		}
+
+		if released != 0 {
+			log.Printf("released %d leases of pool %s held by previous processes of node %s", released, name, node)
+			exportPool(name, p)
+		}
+	}
+}
+
+// leased checks whether a resource of the pool is leased.
+//
+// Parameters:
+//   - resource: The resource to check
+//
+// Returns:
+//   - bool: true if a lease is held on the resource
+//
+// Must be called with s.mu held.
+func (p *pool) leased(resource string) bool {
+	for _, lease := range p.leases {
+		if lease.Resource == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// release drops a lease and returns its resource to the free ones, unless it has been removed from the pool.
+//
+// Parameters:
+//   - id: ID of the lease to release
+//
+// Must be called with s.mu held.
+func (p *pool) release(id string) {
+	lease := p.leases[id]
+	delete(p.leases, id)
+
+	if slices.Contains(p.resources, lease.Resource) {
+		p.free = append(p.free, lease.Resource)
+		p.wake()
+	}
+}
+
+// wake notifies the users waiting for a resource of the pool.
+//
+// Must be called with s.mu held.
+func (p *pool) wake() {
+	close(p.released)
+	p.released = make(chan any)
+}
+
+// details builds the details of the pool.
+//
+// Parameters:
+//   - name: Name of the pool
+//
+// Returns:
+//   - core.LeasePoolDetails: Details of the pool
+//
+// Must be called with s.mu held.
+func (p *pool) details(name string) core.LeasePoolDetails {
+	leases := make([]core.Lease, 0, len(p.leases))
+	for _, lease := range p.leases {
+		leases = append(leases, lease)
+	}
+
+	return core.LeasePoolDetails{
+		LeasePool: core.LeasePool{
+			Name:      name,
+			Resources: slices.Clone(p.resources),
+		},
+		Leases:    leases,
+		Waiting:   p.waiting,
+		UpdatedAt: p.updatedAt,
+	}
+}
+
+// exportPool updates the metrics of a pool.
+//
+// Parameters:
+//   - name: Name of the pool
+//   - p: The pool
+//
+// Must be called with s.mu held.
+func exportPool(name string, p *pool) {
+	metrics.LeasePoolSizeGauge.WithLabelValues(name).Set(float64(len(p.resources)))
+	metrics.LeasedResourcesGauge.WithLabelValues(name).Set(float64(len(p.leases)))
+	metrics.LeaseWaitingGauge.WithLabelValues(name).Set(float64(p.waiting))
+
+	utilization := 0.0
+	if len(p.resources) != 0 {
+		utilization = float64(len(p.leases)) / float64(len(p.resources))
+	}
+	metrics.LeasePoolUtilizationGauge.WithLabelValues(name).Set(utilization)
+}
@@ -3,6 +3,7 @@ package node
 import (
 	"context"
 	"load-generation-system/internal/core"
+	"maps"
 	"slices"
 	"sync"
 	"time"
@@ -12,6 +13,7 @@ import (
 // It manages attack operations, maintains state, and handles operation retries.
 type node struct {
 	name      string                          // Name identifier for the node
+	instance  string                          // ID of the node process
 	scenarios map[string]core.ScenarioDetails // Available scenarios on this node
 	attacks   map[int64]core.AttackDetails    // Active attacks on this node
 	isActive  bool                            // Whether the node is currently active
@@ -26,13 +28,14 @@ type node struct {
 }
 
 func New(
-	name string,
+	name, instance string,
 	scenarios map[string]core.ScenarioDetails,
 	ops chan core.Operation,
 	opQueueCapacity, retryIntervalSec int64,
 ) core.Node {
 	return &node{
 		name:          name,
+		instance:      instance,
 		scenarios:     scenarios,
 		attacks:       make(map[int64]core.AttackDetails),
 		ops:           ops,
@@ -111,40 +114,80 @@ func (n *node) StartAttack(start core.OperationStart) error {
 	n.mu.Lock()
 	// Prepare increment details
 	increment := core.IncrementDetails{
-		ID:        start.IncrementID,
-		AttackID:  start.AttackID,
-		Scenarios: start.Scenarios,
+		ID:                start.IncrementID,
+		AttackID:          start.AttackID,
+		Scenarios:         start.Scenarios,
+		ArrivalRates:      start.ArrivalRates,
+		Pacings:           start.Pacings,
+		Iterations:        start.Iterations,
+		IterationsPerUser: start.IterationsPerUser,
+		Feeds:             start.Feeds,
 	}
 
 	// Update existing attack or create new one
 	if attack, exists := n.attacks[start.AttackID]; exists {
-		var incrementDetails *core.IncrementDetails
-		// Find existing increment
-		for _, increment := range attack.Increments {
-			if increment.ID == start.IncrementID {
-				temp := increment
-				incrementDetails = &temp
-				break
-			}
-		}
+		index := slices.IndexFunc(attack.Increments, func(increment core.IncrementDetails) bool {
+			return increment.ID == start.IncrementID
+		})
 
-		if incrementDetails != nil {
-			// Update existing increment counters
+		increments := slices.Clone(attack.Increments)
+		if index != -1 {
+			// Add the counters and arrival rates to the existing increment
+			scenarios := make(map[string]int64, len(increments[index].Scenarios))
+			for name, counter := range increments[index].Scenarios {
+				scenarios[name] = counter
+			}
 			for name, counter := range start.Scenarios {
-				scenarioCounter := incrementDetails.Scenarios[name]
-				scenarioCounter += counter
-				incrementDetails.Scenarios[name] = scenarioCounter
+				scenarios[name] += counter
+			}
+
+			rates := make(map[string]core.ArrivalRate, len(increments[index].ArrivalRates))
+			for name, rate := range increments[index].ArrivalRates {
+				rates[name] = rate
+			}
+			for name, rate := range start.ArrivalRates {
+				scenarioRate := rates[name]
+				scenarioRate.Rate += rate.Rate
+				scenarioRate.TargetRate += rate.TargetRate
+				scenarioRate.RampUpSec = rate.RampUpSec
+				rates[name] = scenarioRate
+			}
+
+			// The iteration budgets of the node add up as well
+			if start.Iterations != nil {
+				iterations := *start.Iterations
+				if increments[index].Iterations != nil {
+					iterations += *increments[index].Iterations
+				}
+				increments[index].Iterations = &iterations
+			}
+
+			pacings := make(map[string]core.Pacing, len(increments[index].Pacings))
+			for name, pacing := range increments[index].Pacings {
+				pacings[name] = pacing
+			}
+			for name, pacing := range start.Pacings {
+				pacings[name] = pacing
 			}
+
+			increments[index].Scenarios = scenarios
+			increments[index].ArrivalRates = rates
+			increments[index].Pacings = pacings
+			increments[index].Feeds = append(slices.Clone(increments[index].Feeds), start.Feeds...)
 		} else {
 			// Add new increment to existing attack
-			attack.Increments = append(attack.Increments, increment)
-			n.attacks[start.AttackID] = attack
+			increments = append(increments, increment)
 		}
+
+		attack.Increments = increments
+		n.attacks[start.AttackID] = attack
 	} else {
 		// Create new attack
 		incrementDetails := []core.IncrementDetails{increment}
 		n.attacks[start.AttackID] = core.AttackDetails{
 			ID:         start.AttackID,
+			Target:     start.Target,
+			Params:     start.Params,
 			Increments: incrementDetails,
 		}
 	}
@@ -152,7 +195,10 @@ func (n *node) StartAttack(start core.OperationStart) error {
 
 	// Validate all scenarios exist
 	for scenario := range start.Scenarios {
-		if _, ok := n.scenarios[scenario]; !ok {
+		n.mu.Lock()
+		_, ok := n.scenarios[scenario]
+		n.mu.Unlock()
+		if !ok {
 			n.opQueue <- core.Operation{
 				Kill: &core.OperationKill{}, // Send kill if invalid scenario
 			}
@@ -170,6 +216,8 @@ func (n *node) StartAttack(start core.OperationStart) error {
 
 // StopAttack terminates an existing attack or increment.
 // It updates the attack state and queues the stop operation.
+// An attack whose last increment is stopped stays on the node until it is stopped as a whole,
+// as the node keeps its attack-level hooks and dataset rows meanwhile.
 //
 // Parameters:
 //   - stop: Operation details for stopping the attack
@@ -200,13 +248,9 @@ func (n *node) StopAttack(stop core.OperationStop) error {
 			return core.ErrIncrementNotFound
 		}
 
-		// Remove increment or entire attack
-		if len(attack.Increments) > 1 {
-			attack.Increments = slices.Delete(attack.Increments, *incrementID, *incrementID+1)
-			n.attacks[stop.AttackID] = attack
-		} else {
-			delete(n.attacks, stop.AttackID)
-		}
+		// Remove increment
+		attack.Increments = slices.Delete(slices.Clone(attack.Increments), *incrementID, *incrementID+1)
+		n.attacks[stop.AttackID] = attack
 	} else {
 		// Remove entire attack
 		delete(n.attacks, stop.AttackID)
@@ -221,6 +265,186 @@ func (n *node) StopAttack(stop core.OperationStop) error {
 	return nil
 }
 
+// ReduceAttack retires part of the users of an existing increment.
+// It updates the increment counters and queues the reduce operation.
+//
+// Parameters:
+//   - reduce: Operation details for reducing the increment
+//
+// Returns:
+//   - error: ErrAttackNotFound or ErrIncrementNotFound if invalid ID
+func (n *node) ReduceAttack(reduce core.OperationReduce) error {
+	n.mu.Lock()
+	attack, exists := n.attacks[reduce.AttackID]
+	if !exists {
+		n.mu.Unlock()
+		return core.ErrAttackNotFound
+	}
+
+	index := slices.IndexFunc(attack.Increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == reduce.IncrementID
+	})
+	if index == -1 {
+		n.mu.Unlock()
+		return core.ErrIncrementNotFound
+	}
+
+	// Compute the remaining counters
+	scenarios := make(map[string]int64)
+	for name, counter := range attack.Increments[index].Scenarios {
+		if remaining := counter - reduce.Scenarios[name]; remaining > 0 {
+			scenarios[name] = remaining
+		}
+	}
+
+	// Remove the increment if nothing remains, the attack stays until it is stopped as a whole
+	increments := slices.Clone(attack.Increments)
+	if len(scenarios) != 0 {
+		increments[index].Scenarios = scenarios
+	} else {
+		increments = slices.Delete(increments, index, index+1)
+	}
+	attack.Increments = increments
+	n.attacks[reduce.AttackID] = attack
+	n.mu.Unlock()
+
+	// Queue reduce operation
+	n.opQueue <- core.Operation{
+		Reduce: &reduce,
+	}
+
+	return nil
+}
+
+// RescaleAttack changes the arrival rates of an existing increment.
+// It updates the increment arrival rates and queues the rescale operation.
+//
+// Parameters:
+//   - rescale: Operation details for rescaling the increment
+//
+// Returns:
+//   - error: ErrAttackNotFound or ErrIncrementNotFound if invalid ID
+func (n *node) RescaleAttack(rescale core.OperationRescale) error {
+	n.mu.Lock()
+	attack, exists := n.attacks[rescale.AttackID]
+	if !exists {
+		n.mu.Unlock()
+		return core.ErrAttackNotFound
+	}
+
+	index := slices.IndexFunc(attack.Increments, func(increment core.IncrementDetails) bool {
+		return increment.ID == rescale.IncrementID
+	})
+	if index == -1 {
+		n.mu.Unlock()
+		return core.ErrIncrementNotFound
+	}
+
+	// Keep the rates of the scenarios the operation leaves out
+	rates := maps.Clone(attack.Increments[index].ArrivalRates)
+	for name, rate := range rescale.ArrivalRates {
+		if _, exists := rates[name]; exists {
+			rates[name] = rate
+		}
+	}
+
+	increments := slices.Clone(attack.Increments)
+	increments[index].ArrivalRates = rates
+	attack.Increments = increments
+	n.attacks[rescale.AttackID] = attack
+	n.mu.Unlock()
+
+	// Queue rescale operation
+	n.opQueue <- core.Operation{
+		Rescale: &rescale,
+	}
+
+	return nil
+}
+
+// PauseAttack suspends an existing attack.
+// It marks the attack as paused and queues the pause operation.
+//
+// Parameters:
+//   - pause: Operation details for pausing the attack
+//
+// Returns:
+//   - error: ErrAttackNotFound if invalid ID
+func (n *node) PauseAttack(pause core.OperationPause) error {
+	n.mu.Lock()
+	attack, exists := n.attacks[pause.AttackID]
+	if !exists {
+		n.mu.Unlock()
+		return core.ErrAttackNotFound
+	}
+
+	attack.Paused = true
+	n.attacks[pause.AttackID] = attack
+	n.mu.Unlock()
+
+	// Queue pause operation
+	n.opQueue <- core.Operation{
+		Pause: &pause,
+	}
+
+	return nil
+}
+
+// ResumeAttack continues a paused attack.
+// It clears the paused mark of the attack and queues the resume operation.
+//
+// Parameters:
+//   - resume: Operation details for resuming the attack
+//
+// Returns:
+//   - error: ErrAttackNotFound if invalid ID
+func (n *node) ResumeAttack(resume core.OperationResume) error {
+	n.mu.Lock()
+	attack, exists := n.attacks[resume.AttackID]
+	if !exists {
+		n.mu.Unlock()
+		return core.ErrAttackNotFound
+	}
+
+	attack.Paused = false
+	n.attacks[resume.AttackID] = attack
+	n.mu.Unlock()
+
+	// Queue resume operation
+	n.opQueue <- core.Operation{
+		Resume: &resume,
+	}
+
+	return nil
+}
+
+// AddScenario registers an uploaded scenario on the node.
+// The scenario is available to the following start operations right away,
+// as they are queued after the scenario operation.
+//
+// Parameters:
+//   - scenario: Operation details of the uploaded scenario
+//
+// Returns:
+//   - error: Currently always returns nil
+func (n *node) AddScenario(scenario core.OperationScenario) error {
+	n.mu.Lock()
+	n.scenarios[scenario.Name] = core.ScenarioDetails{
+		Name:        scenario.Name,
+		Description: scenario.Description,
+		Version:     scenario.Version,
+		Parameters:  scenario.Parameters,
+	}
+	n.mu.Unlock()
+
+	// Queue scenario operation
+	n.opQueue <- core.Operation{
+		Scenario: &scenario,
+	}
+
+	return nil
+}
+
 // GetDetails returns the current state and configuration of the node.
 //
 // Returns:
@@ -230,18 +454,20 @@ func (n *node) GetDetails() core.NodeDetails {
 	// Copy active attacks
 	attacks := make([]core.AttackDetails, 0, len(n.attacks))
 	for _, attack := range n.attacks {
+		attack.Increments = slices.Clone(attack.Increments)
 		attacks = append(attacks, attack)
 	}
-	n.mu.Unlock()
 
 	// Copy available scenarios
 	scenarios := make([]core.ScenarioDetails, 0, len(n.scenarios))
 	for _, scenario := range n.scenarios {
 		scenarios = append(scenarios, scenario)
 	}
+	n.mu.Unlock()
 
 	return core.NodeDetails{
 		Name:      n.name,
+		Instance:  n.instance,
 		Scenarios: scenarios,
 		Attacks:   attacks,
 		IsActive:  n.isActive,
@@ -0,0 +1,291 @@
+package schedule
+
+import (
+	"errors"
+	"load-generation-system/internal/core"
+	"load-generation-system/pkg/scheduler"
+	"log"
+	"slices"
+	"sync"
+	"time"
+)
+
+// maxRuns bounds the number of the most recent runs kept per schedule.
+const maxRuns = 100
+
+// scheduleService implements core.ScheduleService and starts attacks at the scheduled moments.
+//
+// Fields:
+//   - attackService: Service starting the scheduled attacks
+//   - scheduler: Scheduler running a job per schedule
+//   - schedules: Schedules indexed by schedule ID
+//   - scheduleSeq: Sequence counter for generating unique schedule IDs
+//   - mu: Read-write mutex for concurrent access protection
+type scheduleService struct {
+	attackService core.AttackService   // Service starting the attacks
+	scheduler     *scheduler.Scheduler // Scheduler of the runs
+	schedules     map[int64]schedule   // Schedules
+	scheduleSeq   int64                // Schedule ID sequence counter
+	mu            sync.RWMutex         // Concurrency control
+}
+
+// schedule represents a single schedule with the job running it.
+type schedule struct {
+	details core.ScheduleDetails // Schedule details
+	cron    *scheduler.Cron      // Parsed cron expression of recurring schedules
+	jobID   string               // ID of the scheduler job
+}
+
+// NewService creates a new schedule service instance.
+//
+// Parameters:
+//   - attackService: Service starting the scheduled attacks
+//
+// Returns:
+//   - core.ScheduleService: Initialized schedule service
+func NewService(attackService core.AttackService) core.ScheduleService {
+	return &scheduleService{
+		attackService: attackService,
+		scheduler:     scheduler.New(),
+		schedules:     make(map[int64]schedule),
+	}
+}
+
+// CreateSchedule creates a new schedule and plans its runs.
+//
+// Parameters:
+//   - start: Configuration of the schedule
+//
+// Returns:
+//   - core.ScheduleDetails: Details of the created schedule
+//   - error: Possible errors:
+//   - core.ErrBadConfig if the timing is invalid
+//   - core.ErrBrokenScheduler if the run job cannot be created
+func (s *scheduleService) CreateSchedule(start core.StartSchedule) (core.ScheduleDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sched := schedule{
+		details: core.ScheduleDetails{
+			ID:        s.scheduleSeq,
+			CreatedAt: time.Now().UTC().Truncate(time.Second),
+		},
+	}
+	if err := s.plan(&sched, start); err != nil {
+		return core.ScheduleDetails{}, err
+	}
+
+	s.scheduleSeq++
+	s.schedules[sched.details.ID] = sched
+
+	return sched.details, nil
+}
+
+// UpdateSchedule replaces the configuration of a schedule and replans its runs.
+// The history of the past runs is kept.
+//
+// Parameters:
+//   - scheduleID: ID of the schedule to update
+//   - start: New configuration of the schedule
+//
+// Returns:
+//   - core.ScheduleDetails: Details of the updated schedule
+//   - error: Possible errors:
+//   - core.ErrScheduleNotFound if the schedule doesn't exist
+//   - core.ErrBadConfig if the timing is invalid
+//   - core.ErrBrokenScheduler if the run job cannot be created
+func (s *scheduleService) UpdateSchedule(scheduleID int64, start core.StartSchedule) (core.ScheduleDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sched, exists := s.schedules[scheduleID]
+	if !exists {
+		return core.ScheduleDetails{}, core.ErrScheduleNotFound
+	}
+
+	previousJobID := sched.jobID
+	if err := s.plan(&sched, start); err != nil {
+		return core.ScheduleDetails{}, err
+	}
+	s.removeJob(previousJobID)
+
+	s.schedules[scheduleID] = sched
+
+	return sched.details, nil
+}
+
+// CancelSchedule removes a schedule and cancels its future runs.
+// Attacks already started by the schedule keep running.
+//
+// Parameters:
+//   - scheduleID: ID of the schedule to cancel
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrScheduleNotFound if the schedule doesn't exist
+func (s *scheduleService) CancelSchedule(scheduleID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sched, exists := s.schedules[scheduleID]
+	if !exists {
+		return core.ErrScheduleNotFound
+	}
+
+	s.removeJob(sched.jobID)
+	delete(s.schedules, scheduleID)
+
+	return nil
+}
+
+// GetSchedules retrieves details of all schedules.
+//
+// Returns:
+//   - []core.ScheduleDetails: A slice containing details of all schedules
+func (s *scheduleService) GetSchedules() []core.ScheduleDetails {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	schedules := make([]core.ScheduleDetails, 0, len(s.schedules))
+	for _, sched := range s.schedules {
+		schedules = append(schedules, sched.details)
+	}
+
+	return schedules
+}
+
+// plan applies the configuration to a schedule and creates the job running it.
+//
+// Parameters:
+//   - sched: The schedule to configure
+//   - start: Configuration of the schedule
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrBadConfig if the timing is invalid
+//   - core.ErrBrokenScheduler if the run job cannot be created
+//
+// Must be called with s.mu held.
+func (s *scheduleService) plan(sched *schedule, start core.StartSchedule) error {
+	if (start.StartAt == nil) == (start.Cron == nil) {
+		return core.ErrBadConfig
+	}
+
+	scheduleID := sched.details.ID
+	task := func() {
+		s.run(scheduleID)
+	}
+
+	var cron *scheduler.Cron
+	var nextRunAt time.Time
+	var jobID string
+	var err error
+	if start.Cron != nil {
+		parsed, parseErr := scheduler.ParseCron(*start.Cron)
+		if parseErr != nil {
+			return core.ErrBadConfig
+		}
+		next, ok := parsed.Next(time.Now())
+		if !ok {
+			return core.ErrBadConfig
+		}
+
+		cron = &parsed
+		nextRunAt = next
+		jobID, err = s.scheduler.NewCronJob(parsed, task)
+	} else {
+		if !start.StartAt.After(time.Now()) {
+			return core.ErrBadConfig
+		}
+
+		nextRunAt = start.StartAt.UTC()
+		jobID, err = s.scheduler.NewOneShotJob(nextRunAt, task)
+	}
+	if err != nil {
+		log.Printf("error scheduling runs of schedule %d: %v", scheduleID, err)
+		return core.ErrBrokenScheduler
+	}
+
+	sched.details.Name = start.Name
+	sched.details.StartAt = start.StartAt
+	sched.details.Cron = start.Cron
+	sched.details.Attack = start.Attack
+	sched.details.NextRunAt = &nextRunAt
+	sched.cron = cron
+	sched.jobID = jobID
+
+	return nil
+}
+
+// run starts the attack of a schedule and records the outcome of the run.
+//
+// Parameters:
+//   - scheduleID: ID of the schedule to run
+func (s *scheduleService) run(scheduleID int64) {
+	s.mu.Lock()
+	sched, exists := s.schedules[scheduleID]
+	if !exists {
+		s.mu.Unlock()
+		return
+	}
+
+	run := core.ScheduleRun{
+		StartedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	if len(sched.details.Runs) != 0 {
+		run.Run = sched.details.Runs[len(sched.details.Runs)-1].Run + 1
+	}
+
+	start := sched.details.Attack
+	start.ScheduledRun = &core.ScheduledRun{
+		ScheduleID: scheduleID,
+		Run:        run.Run,
+	}
+	s.mu.Unlock()
+
+	// The attack is started outside the lock, as it waits for the nodes
+	attackDetails, err := s.attackService.StartAttack(start)
+	if err != nil {
+		log.Printf("error starting attack of schedule %d: %v", scheduleID, err)
+		reason := err.Error()
+		run.Error = &reason
+	} else {
+		run.AttackID = &attackDetails.ID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The schedule may have been cancelled while the attack was starting
+	sched, exists = s.schedules[scheduleID]
+	if !exists {
+		return
+	}
+
+	runs := append(slices.Clone(sched.details.Runs), run)
+	if len(runs) > maxRuns {
+		runs = runs[len(runs)-maxRuns:]
+	}
+	sched.details.Runs = runs
+
+	sched.details.NextRunAt = nil
+	if sched.cron != nil {
+		if next, ok := sched.cron.Next(time.Now()); ok {
+			sched.details.NextRunAt = &next
+		}
+	}
+
+	s.schedules[scheduleID] = sched
+}
+
+// removeJob stops the job running a schedule.
+//
+// Parameters:
+//   - jobID: ID of the job to stop
+//
+// Jobs of the one-shot schedules are already gone after their run, so a missing job is not an error.
+func (s *scheduleService) removeJob(jobID string) {
+	if err := s.scheduler.RemoveJob(jobID); err != nil && !errors.Is(err, core.ErrJobNotFound) {
+		log.Printf("error removing job %s: %v", jobID, err)
+	}
+}
@@ -0,0 +1,305 @@
+package suite
+
+import (
+	"errors"
+	"fmt"
+	"load-generation-system/internal/core"
+	"log"
+	"slices"
+	"sync"
+	"time"
+)
+
+// suiteService implements core.SuiteService and runs the steps of every suite one after another.
+//
+// Fields:
+//   - attackService: Service running the attacks of the steps
+//   - suites: Suites indexed by suite ID
+//   - suiteSeq: Sequence counter for generating unique suite IDs
+//   - mu: Read-write mutex for concurrent access protection
+type suiteService struct {
+	attackService core.AttackService // Service running the attacks
+	suites        map[int64]suite    // Suites
+	suiteSeq      int64              // Suite ID sequence counter
+	mu            sync.RWMutex       // Concurrency control
+}
+
+// suite represents a single suite with the channel cancelling its run.
+type suite struct {
+	details core.SuiteDetails // Suite details
+	cancel  chan any          // Channel closed when the suite is cancelled
+}
+
+// NewService creates a new suite service instance.
+//
+// Parameters:
+//   - attackService: Service running the attacks of the steps
+//
+// Returns:
+//   - core.SuiteService: Initialized suite service
+func NewService(attackService core.AttackService) core.SuiteService {
+	return &suiteService{
+		attackService: attackService,
+		suites:        make(map[int64]suite),
+	}
+}
+
+// StartSuite creates a new suite and starts running its steps.
+//
+// Parameters:
+//   - start: Configuration of the suite
+//
+// Returns:
+//   - core.SuiteDetails: Details of the created suite
+//   - error: Possible errors:
+//   - core.ErrBadConfig if the suite has no steps, a delay is negative or the first step has a gate
+func (s *suiteService) StartSuite(start core.StartSuite) (core.SuiteDetails, error) {
+	if len(start.Steps) == 0 || start.Steps[0].Gate != nil {
+		return core.SuiteDetails{}, core.ErrBadConfig
+	}
+	for _, step := range start.Steps {
+		if step.DelaySec < 0 {
+			return core.SuiteDetails{}, core.ErrBadConfig
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activeStep := 0
+	created := suite{
+		details: core.SuiteDetails{
+			ID:         s.suiteSeq,
+			Name:       start.Name,
+			Steps:      start.Steps,
+			Status:     core.SuiteRunning,
+			ActiveStep: &activeStep,
+			CreatedAt:  time.Now().UTC().Truncate(time.Second),
+		},
+		cancel: make(chan any),
+	}
+	s.suiteSeq++
+	s.suites[created.details.ID] = created
+
+	go s.run(created)
+
+	return created.details, nil
+}
+
+// CancelSuite cancels a running suite. The attack of the active step is stopped
+// and the remaining steps are skipped.
+//
+// Parameters:
+//   - suiteID: ID of the suite to cancel
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrSuiteNotFound if the suite doesn't exist
+//   - core.ErrSuiteEnded if the suite is not running anymore
+func (s *suiteService) CancelSuite(suiteID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	suite, exists := s.suites[suiteID]
+	if !exists {
+		return core.ErrSuiteNotFound
+	}
+	if suite.details.Status != core.SuiteRunning {
+		return core.ErrSuiteEnded
+	}
+
+	close(suite.cancel)
+	s.finish(&suite.details, core.SuiteCancelled)
+	s.suites[suiteID] = suite
+
+	return nil
+}
+
+// GetSuites retrieves details of all suites.
+//
+// Returns:
+//   - []core.SuiteDetails: A slice containing details of all suites
+func (s *suiteService) GetSuites() []core.SuiteDetails {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	suites := make([]core.SuiteDetails, 0, len(s.suites))
+	for _, suite := range s.suites {
+		suites = append(suites, suite.details)
+	}
+
+	return suites
+}
+
+// run goes through the steps of a suite until all of them have run, a step fails or the suite is cancelled.
+//
+// Parameters:
+//   - suite: The suite to run
+func (s *suiteService) run(suite suite) {
+	suiteID := suite.details.ID
+
+	var previous *core.AttackSummary
+	for i, step := range suite.details.Steps {
+		if !s.update(suiteID, func(details *core.SuiteDetails) {
+			details.ActiveStep = &i
+			details.Results = append(slices.Clone(details.Results), core.SuiteStepResult{
+				Step: i,
+			})
+		}) {
+			return
+		}
+
+		// Wait before the step, the suite may be cancelled meanwhile
+		if i > 0 && step.DelaySec > 0 {
+			timer := time.NewTimer(time.Duration(step.DelaySec) * time.Second)
+			select {
+			case <-timer.C:
+			case <-suite.cancel:
+				timer.Stop()
+				return
+			}
+		}
+
+		if previous != nil && step.Gate != nil {
+			if reason := checkGate(step.Gate, *previous); reason != "" {
+				s.fail(suiteID, i, "gate is not met: "+reason)
+				return
+			}
+		}
+
+		summary, ok := s.runStep(suite, i, step)
+		if !ok {
+			return
+		}
+		previous = &summary
+	}
+
+	s.update(suiteID, func(details *core.SuiteDetails) {
+		s.finish(details, core.SuitePassed)
+	})
+}
+
+// runStep starts the attack of a step and waits for it to end.
+//
+// Parameters:
+//   - suite: The suite the step belongs to
+//   - i: Index of the step
+//   - step: The step to run
+//
+// Returns:
+//   - core.AttackSummary: Summary of the ended attack
+//   - bool: false if the step failed or the suite was cancelled
+func (s *suiteService) runStep(suite suite, i int, step core.SuiteStep) (core.AttackSummary, bool) {
+	suiteID := suite.details.ID
+
+	// The attack is started outside the lock, as it waits for the nodes
+	attackDetails, ended, err := s.attackService.StartAndWaitAttack(step.Attack)
+	if err != nil {
+		log.Printf("error starting attack of suite %d step %d: %v", suiteID, i, err)
+		s.fail(suiteID, i, err.Error())
+		return core.AttackSummary{}, false
+	}
+
+	if !s.update(suiteID, func(details *core.SuiteDetails) {
+		details.Results = slices.Clone(details.Results)
+		details.Results[i].AttackID = &attackDetails.ID
+		details.Results[i].StartedAt = &attackDetails.CreatedAt
+	}) {
+		// Cancelled while the attack was starting
+		s.stopAttack(attackDetails.ID)
+		return core.AttackSummary{}, false
+	}
+
+	select {
+	case summary := <-ended:
+		return summary, s.update(suiteID, func(details *core.SuiteDetails) {
+			details.Results = slices.Clone(details.Results)
+			details.Results[i].Summary = &summary
+		})
+	case <-suite.cancel:
+		s.stopAttack(attackDetails.ID)
+		return core.AttackSummary{}, false
+	}
+}
+
+// checkGate checks the outcome of the previous attack against a gate.
+//
+// Parameters:
+//   - gate: The gate of the step
+//   - summary: Summary of the previous attack
+//
+// Returns:
+//   - string: The unmet condition, empty if the gate is met
+func checkGate(gate *core.SuiteGate, summary core.AttackSummary) string {
+	switch {
+	case gate.MaxErrorRate != nil && summary.ErrorRate > *gate.MaxErrorRate:
+		return fmt.Sprintf("error rate %.4f is above %.4f", summary.ErrorRate, *gate.MaxErrorRate)
+	case gate.MaxP95Ms != nil && summary.P95Ms > *gate.MaxP95Ms:
+		return fmt.Sprintf("p95 latency %.1fms is above %.1fms", summary.P95Ms, *gate.MaxP95Ms)
+	case gate.MinRequests != nil && summary.Requests < *gate.MinRequests:
+		return fmt.Sprintf("%d requests are below %d", summary.Requests, *gate.MinRequests)
+	}
+
+	return ""
+}
+
+// stopAttack stops the attack of a cancelled suite.
+//
+// Parameters:
+//   - attackID: ID of the attack to stop
+//
+// An attack that has already ended is not an error.
+func (s *suiteService) stopAttack(attackID int64) {
+	if err := s.attackService.StopAttack(attackID, core.StopOptions{}); err != nil && !errors.Is(err, core.ErrAttackNotFound) {
+		log.Printf("error stopping attack %d: %v", attackID, err)
+	}
+}
+
+// fail records the failure of a step and ends the suite.
+//
+// Parameters:
+//   - suiteID: ID of the suite
+//   - i: Index of the failed step
+//   - reason: Reason of the failure
+func (s *suiteService) fail(suiteID int64, i int, reason string) {
+	s.update(suiteID, func(details *core.SuiteDetails) {
+		details.Results = slices.Clone(details.Results)
+		details.Results[i].Error = &reason
+		s.finish(details, core.SuiteFailed)
+	})
+}
+
+// finish ends a suite with the given status.
+//
+// Parameters:
+//   - details: Details of the suite
+//   - status: Final status of the suite
+func (s *suiteService) finish(details *core.SuiteDetails, status core.SuiteStatus) {
+	endedAt := time.Now().UTC().Truncate(time.Second)
+	details.Status = status
+	details.ActiveStep = nil
+	details.EndedAt = &endedAt
+}
+
+// update applies changes to the details of a running suite.
+//
+// Parameters:
+//   - suiteID: ID of the suite
+//   - update: Function modifying the details
+//
+// Returns:
+//   - bool: false if the suite is not running anymore, the details are left intact then
+func (s *suiteService) update(suiteID int64, update func(details *core.SuiteDetails)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	suite, exists := s.suites[suiteID]
+	if !exists || suite.details.Status != core.SuiteRunning {
+		return false
+	}
+
+	update(&suite.details)
+	s.suites[suiteID] = suite
+
+	return true
+}
@@ -0,0 +1,110 @@
+package target
+
+import (
+	"load-generation-system/internal/core"
+	"maps"
+	"sync"
+	"time"
+)
+
+// targetService implements core.TargetService and keeps the targets the attacks can be run against.
+//
+// Fields:
+//   - targets: Targets indexed by name
+//   - mu: Read-write mutex for concurrent access protection
+type targetService struct {
+	targets map[string]core.TargetDetails // Targets
+	mu      sync.RWMutex                  // Concurrency control
+}
+
+// NewService creates a new target service instance.
+//
+// Returns:
+//   - core.TargetService: Initialized target service
+func NewService() core.TargetService {
+	return &targetService{
+		targets: make(map[string]core.TargetDetails),
+	}
+}
+
+// SaveTarget creates a target or replaces the one with the same name.
+// Attacks already running against the previous version of the target keep it.
+//
+// Parameters:
+//   - target: Configuration of the target
+//
+// Returns:
+//   - core.TargetDetails: Details of the saved target
+//   - error: Currently always returns nil
+func (s *targetService) SaveTarget(target core.Target) (core.TargetDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target.Services = maps.Clone(target.Services)
+	target.Headers = maps.Clone(target.Headers)
+
+	targetDetails := core.TargetDetails{
+		Target:    target,
+		UpdatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	s.targets[target.Name] = targetDetails
+
+	return targetDetails, nil
+}
+
+// GetTarget retrieves a target by its name.
+//
+// Parameters:
+//   - name: Name of the target
+//
+// Returns:
+//   - core.TargetDetails: Details of the target
+//   - error: Possible errors:
+//   - core.ErrTargetNotFound if the target doesn't exist
+func (s *targetService) GetTarget(name string) (core.TargetDetails, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	targetDetails, exists := s.targets[name]
+	if !exists {
+		return core.TargetDetails{}, core.ErrTargetNotFound
+	}
+
+	return targetDetails, nil
+}
+
+// GetTargets retrieves details of all targets.
+//
+// Returns:
+//   - []core.TargetDetails: A slice containing details of all targets
+func (s *targetService) GetTargets() []core.TargetDetails {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	targets := make([]core.TargetDetails, 0, len(s.targets))
+	for _, targetDetails := range s.targets {
+		targets = append(targets, targetDetails)
+	}
+
+	return targets
+}
+
+// DeleteTarget removes a target. Attacks already running against it keep their copy.
+//
+// Parameters:
+//   - name: Name of the target to delete
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrTargetNotFound if the target doesn't exist
+func (s *targetService) DeleteTarget(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.targets[name]; !exists {
+		return core.ErrTargetNotFound
+	}
+	delete(s.targets, name)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package template
+
+import (
+	"fmt"
+	"load-generation-system/internal/core"
+	"regexp"
+)
+
+// placeholderPattern matches the "${name}" placeholders of the template parameters.
+var placeholderPattern = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// parameterNamePattern matches the names the parameters can be referenced by.
+var parameterNamePattern = regexp.MustCompile(`^\w+$`)
+
+// validateParameters checks that the parameters are well-formed and that every placeholder
+// of the attack refers to a declared parameter.
+//
+// Parameters:
+//   - parameters: Declared parameters of the template
+//   - attack: Attack configuration holding the placeholders
+//
+// Returns:
+//   - error: core.ErrTemplateParameter if a parameter name is invalid or duplicated,
+//     or if a placeholder refers to an undeclared parameter
+func validateParameters(parameters []core.TemplateParameter, attack map[string]any) error {
+	declared := make(map[string]bool, len(parameters))
+	for _, parameter := range parameters {
+		if !parameterNamePattern.MatchString(parameter.Name) || declared[parameter.Name] {
+			return core.ErrTemplateParameter
+		}
+		declared[parameter.Name] = true
+	}
+
+	for _, name := range placeholders(attack) {
+		if !declared[name] {
+			return core.ErrTemplateParameter
+		}
+	}
+
+	return nil
+}
+
+// placeholders collects the parameter names referenced within a JSON value.
+//
+// Parameters:
+//   - value: Decoded JSON value
+//
+// Returns:
+//   - []string: Referenced parameter names, possibly repeated
+func placeholders(value any) []string {
+	var names []string
+	switch v := value.(type) {
+	case map[string]any:
+		for _, item := range v {
+			names = append(names, placeholders(item)...)
+		}
+	case []any:
+		for _, item := range v {
+			names = append(names, placeholders(item)...)
+		}
+	case string:
+		for _, match := range placeholderPattern.FindAllStringSubmatch(v, -1) {
+			names = append(names, match[1])
+		}
+	}
+
+	return names
+}
+
+// substitute replaces the placeholders within a JSON value with the parameter values.
+// A string consisting of a single placeholder is replaced by the value itself, keeping its type,
+// so numbers and objects can be parameterized too. Placeholders inside longer strings are
+// replaced by the textual form of the values.
+//
+// Parameters:
+//   - value: Decoded JSON value
+//   - values: Map of parameter names to their values
+//
+// Returns:
+//   - any: A copy of the value with the placeholders replaced
+func substitute(value any, values map[string]any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = substitute(item, values)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = substitute(item, values)
+		}
+		return result
+	case string:
+		if match := placeholderPattern.FindStringSubmatch(v); match != nil && match[0] == v {
+			return values[match[1]]
+		}
+		return placeholderPattern.ReplaceAllStringFunc(v, func(placeholder string) string {
+			return fmt.Sprint(values[placeholderPattern.FindStringSubmatch(placeholder)[1]])
+		})
+	default:
+		return v
+	}
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to a JSON value.
+// Objects are merged recursively, null members are removed and any other value replaces the target.
+//
+// Parameters:
+//   - target: Decoded JSON value to patch, it may be modified in place
+//   - patch: Decoded JSON merge patch
+//
+// Returns:
+//   - any: The patched value
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any)
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
@@ -0,0 +1,278 @@
+package template
+
+import (
+	"load-generation-system/internal/core"
+	"slices"
+	"sync"
+	"time"
+)
+
+// templateService implements core.TemplateService and keeps every version of the attack templates.
+//
+// Fields:
+//   - templates: Versions of the templates indexed by template name, ordered from the oldest one
+//   - mu: Read-write mutex for concurrent access protection
+type templateService struct {
+	templates map[string][]core.TemplateDetails // Template versions
+	mu        sync.RWMutex                      // Concurrency control
+}
+
+// NewService creates a new template service instance.
+//
+// Returns:
+//   - core.TemplateService: Initialized template service
+func NewService() core.TemplateService {
+	return &templateService{
+		templates: make(map[string][]core.TemplateDetails),
+	}
+}
+
+// CreateTemplate creates the first version of a new template.
+//
+// Parameters:
+//   - start: Configuration of the template
+//
+// Returns:
+//   - core.TemplateDetails: Details of the created version
+//   - error: Possible errors:
+//   - core.ErrTemplateExists if a template with the same name exists
+//   - core.ErrBadConfig if the name or the attack is missing
+//   - core.ErrTemplateParameter if the parameters don't match the placeholders
+func (s *templateService) CreateTemplate(start core.StartTemplate) (core.TemplateDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.templates[start.Name]; exists {
+		return core.TemplateDetails{}, core.ErrTemplateExists
+	}
+
+	return s.addVersion(start)
+}
+
+// UpdateTemplate adds a new version to an existing template. The previous versions are kept.
+//
+// Parameters:
+//   - name: Name of the template to update
+//   - start: Configuration of the new version, its name is ignored
+//
+// Returns:
+//   - core.TemplateDetails: Details of the created version
+//   - error: Possible errors:
+//   - core.ErrTemplateNotFound if the template doesn't exist
+//   - core.ErrBadConfig if the attack is missing
+//   - core.ErrTemplateParameter if the parameters don't match the placeholders
+func (s *templateService) UpdateTemplate(name string, start core.StartTemplate) (core.TemplateDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.templates[name]; !exists {
+		return core.TemplateDetails{}, core.ErrTemplateNotFound
+	}
+
+	start.Name = name
+	return s.addVersion(start)
+}
+
+// ImportTemplate creates a template or adds a new version to it if it already exists.
+//
+// Parameters:
+//   - start: Configuration of the template
+//
+// Returns:
+//   - core.TemplateDetails: Details of the created version
+//   - error: Possible errors:
+//   - core.ErrBadConfig if the name or the attack is missing
+//   - core.ErrTemplateParameter if the parameters don't match the placeholders
+func (s *templateService) ImportTemplate(start core.StartTemplate) (core.TemplateDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addVersion(start)
+}
+
+// DeleteTemplate deletes all versions of a template.
+// Attacks launched from the template keep running.
+//
+// Parameters:
+//   - name: Name of the template to delete
+//
+// Returns:
+//   - error: Possible errors:
+//   - core.ErrTemplateNotFound if the template doesn't exist
+func (s *templateService) DeleteTemplate(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.templates[name]; !exists {
+		return core.ErrTemplateNotFound
+	}
+
+	delete(s.templates, name)
+
+	return nil
+}
+
+// GetTemplates retrieves the latest versions of all templates.
+//
+// Returns:
+//   - []core.TemplateDetails: A slice containing the latest version of every template
+func (s *templateService) GetTemplates() []core.TemplateDetails {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	templates := make([]core.TemplateDetails, 0, len(s.templates))
+	for _, versions := range s.templates {
+		templates = append(templates, versions[len(versions)-1])
+	}
+
+	return templates
+}
+
+// GetTemplate retrieves a single version of a template.
+//
+// Parameters:
+//   - name: Name of the template
+//   - version: Version of the template, the latest one if nil
+//
+// Returns:
+//   - core.TemplateDetails: Details of the version
+//   - error: Possible errors:
+//   - core.ErrTemplateNotFound if the template or the version doesn't exist
+func (s *templateService) GetTemplate(name string, version *int64) (core.TemplateDetails, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.getVersion(name, version)
+}
+
+// GetTemplateVersions retrieves all versions of a template.
+//
+// Parameters:
+//   - name: Name of the template
+//
+// Returns:
+//   - []core.TemplateDetails: Versions of the template ordered from the oldest one
+//   - error: Possible errors:
+//   - core.ErrTemplateNotFound if the template doesn't exist
+func (s *templateService) GetTemplateVersions(name string) ([]core.TemplateDetails, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	versions, exists := s.templates[name]
+	if !exists {
+		return nil, core.ErrTemplateNotFound
+	}
+
+	return slices.Clone(versions), nil
+}
+
+// RenderTemplate substitutes the parameters of a template version and applies the overrides.
+//
+// Parameters:
+//   - run: The template version, parameter values and overrides to render
+//
+// Returns:
+//   - map[string]any: The rendered attack configuration in the form of the REST request body
+//   - core.TemplateRef: The rendered template version
+//   - error: Possible errors:
+//   - core.ErrTemplateNotFound if the template or the version doesn't exist
+//   - core.ErrTemplateParameter if a parameter is unknown or a required one is missing
+func (s *templateService) RenderTemplate(run core.RunTemplate) (map[string]any, core.TemplateRef, error) {
+	s.mu.RLock()
+	template, err := s.getVersion(run.Name, run.Version)
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, core.TemplateRef{}, err
+	}
+
+	values := make(map[string]any, len(template.Parameters))
+	for _, parameter := range template.Parameters {
+		if parameter.Default != nil {
+			values[parameter.Name] = parameter.Default
+		}
+	}
+	for name, value := range run.Parameters {
+		if !slices.ContainsFunc(template.Parameters, func(parameter core.TemplateParameter) bool {
+			return parameter.Name == name
+		}) {
+			return nil, core.TemplateRef{}, core.ErrTemplateParameter
+		}
+		values[name] = value
+	}
+	for _, parameter := range template.Parameters {
+		if values[parameter.Name] == nil {
+			return nil, core.TemplateRef{}, core.ErrTemplateParameter
+		}
+	}
+
+	attack := substitute(template.Attack, values).(map[string]any)
+	if run.Overrides != nil {
+		attack = mergePatch(attack, run.Overrides).(map[string]any)
+	}
+
+	return attack, core.TemplateRef{
+		Name:    template.Name,
+		Version: template.Version,
+	}, nil
+}
+
+// addVersion validates the configuration and stores it as the next version of the template.
+//
+// Parameters:
+//   - start: Configuration of the template
+//
+// Returns:
+//   - core.TemplateDetails: Details of the created version
+//   - error: Possible errors:
+//   - core.ErrBadConfig if the name or the attack is missing
+//   - core.ErrTemplateParameter if the parameters don't match the placeholders
+//
+// Must be called with s.mu held.
+func (s *templateService) addVersion(start core.StartTemplate) (core.TemplateDetails, error) {
+	if start.Name == "" || len(start.Attack) == 0 {
+		return core.TemplateDetails{}, core.ErrBadConfig
+	}
+	if err := validateParameters(start.Parameters, start.Attack); err != nil {
+		return core.TemplateDetails{}, err
+	}
+
+	versions := s.templates[start.Name]
+	template := core.TemplateDetails{
+		Name:        start.Name,
+		Version:     int64(len(versions)) + 1,
+		Description: start.Description,
+		Parameters:  start.Parameters,
+		Attack:      start.Attack,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	s.templates[start.Name] = append(versions, template)
+
+	return template, nil
+}
+
+// getVersion finds a version of a template.
+//
+// Parameters:
+//   - name: Name of the template
+//   - version: Version of the template, the latest one if nil
+//
+// Returns:
+//   - core.TemplateDetails: Details of the version
+//   - error: core.ErrTemplateNotFound if the template or the version doesn't exist
+//
+// Must be called with s.mu held.
+func (s *templateService) getVersion(name string, version *int64) (core.TemplateDetails, error) {
+	versions, exists := s.templates[name]
+	if !exists {
+		return core.TemplateDetails{}, core.ErrTemplateNotFound
+	}
+
+	if version == nil {
+		return versions[len(versions)-1], nil
+	}
+	if *version < 1 || *version > int64(len(versions)) {
+		return core.TemplateDetails{}, core.ErrTemplateNotFound
+	}
+
+	return versions[*version-1], nil
+}
@@ -21,31 +21,29 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type AttackRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Request:
-	//
-	//	*AttackRequest_Handshake
-	//	*AttackRequest_Acknowledge
-	Request       isAttackRequest_Request `protobuf_oneof:"request"`
+type LeaseAcquire struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Pool          string                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackRequest) Reset() {
-	*x = AttackRequest{}
+func (x *LeaseAcquire) Reset() {
+	*x = LeaseAcquire{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackRequest) String() string {
+func (x *LeaseAcquire) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackRequest) ProtoMessage() {}
+func (*LeaseAcquire) ProtoMessage() {}
 
-func (x *AttackRequest) ProtoReflect() protoreflect.Message {
+func (x *LeaseAcquire) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -57,74 +55,55 @@ func (x *AttackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackRequest.ProtoReflect.Descriptor instead.
-func (*AttackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseAcquire.ProtoReflect.Descriptor instead.
+func (*LeaseAcquire) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *AttackRequest) GetRequest() isAttackRequest_Request {
+func (x *LeaseAcquire) GetNodeName() string {
 	if x != nil {
-		return x.Request
+		return x.NodeName
 	}
-	return nil
+	return ""
 }
 
-func (x *AttackRequest) GetHandshake() *Handshake {
+func (x *LeaseAcquire) GetPool() string {
 	if x != nil {
-		if x, ok := x.Request.(*AttackRequest_Handshake); ok {
-			return x.Handshake
-		}
+		return x.Pool
 	}
-	return nil
+	return ""
 }
 
-func (x *AttackRequest) GetAcknowledge() *Acknowledge {
+func (x *LeaseAcquire) GetInstanceId() string {
 	if x != nil {
-		if x, ok := x.Request.(*AttackRequest_Acknowledge); ok {
-			return x.Acknowledge
-		}
+		return x.InstanceId
 	}
-	return nil
-}
-
-type isAttackRequest_Request interface {
-	isAttackRequest_Request()
-}
-
-type AttackRequest_Handshake struct {
-	Handshake *Handshake `protobuf:"bytes,1,opt,name=handshake,proto3,oneof"`
-}
-
-type AttackRequest_Acknowledge struct {
-	Acknowledge *Acknowledge `protobuf:"bytes,2,opt,name=acknowledge,proto3,oneof"`
+	return ""
 }
 
-func (*AttackRequest_Handshake) isAttackRequest_Request() {}
-
-func (*AttackRequest_Acknowledge) isAttackRequest_Request() {}
-
-type Handshake struct {
+type LeaseGrant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	Scenarios     []*Scenario            `protobuf:"bytes,2,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pool          string                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Handshake) Reset() {
-	*x = Handshake{}
+func (x *LeaseGrant) Reset() {
+	*x = LeaseGrant{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Handshake) String() string {
+func (x *LeaseGrant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Handshake) ProtoMessage() {}
+func (*LeaseGrant) ProtoMessage() {}
 
-func (x *Handshake) ProtoReflect() protoreflect.Message {
+func (x *LeaseGrant) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -136,47 +115,52 @@ func (x *Handshake) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Handshake.ProtoReflect.Descriptor instead.
-func (*Handshake) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseGrant.ProtoReflect.Descriptor instead.
+func (*LeaseGrant) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Handshake) GetNodeName() string {
+func (x *LeaseGrant) GetId() string {
 	if x != nil {
-		return x.NodeName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Handshake) GetScenarios() []*Scenario {
+func (x *LeaseGrant) GetPool() string {
 	if x != nil {
-		return x.Scenarios
+		return x.Pool
 	}
-	return nil
+	return ""
 }
 
-type Scenario struct {
+func (x *LeaseGrant) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type LeaseRelease struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Scenario) Reset() {
-	*x = Scenario{}
+func (x *LeaseRelease) Reset() {
+	*x = LeaseRelease{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Scenario) String() string {
+func (x *LeaseRelease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Scenario) ProtoMessage() {}
+func (*LeaseRelease) ProtoMessage() {}
 
-func (x *Scenario) ProtoReflect() protoreflect.Message {
+func (x *LeaseRelease) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -188,45 +172,34 @@ func (x *Scenario) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
-func (*Scenario) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaseRelease.ProtoReflect.Descriptor instead.
+func (*LeaseRelease) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Scenario) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Scenario) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-type Acknowledge struct {
+type SetupClaim struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	AttackId      int64                  `protobuf:"varint,2,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	Scenario      string                 `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Acknowledge) Reset() {
-	*x = Acknowledge{}
+func (x *SetupClaim) Reset() {
+	*x = SetupClaim{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Acknowledge) String() string {
+func (x *SetupClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Acknowledge) ProtoMessage() {}
+func (*SetupClaim) ProtoMessage() {}
 
-func (x *Acknowledge) ProtoReflect() protoreflect.Message {
+func (x *SetupClaim) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -238,37 +211,53 @@ func (x *Acknowledge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Acknowledge.ProtoReflect.Descriptor instead.
-func (*Acknowledge) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupClaim.ProtoReflect.Descriptor instead.
+func (*SetupClaim) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{3}
 }
 
-type AttackResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Response:
-	//
-	//	*AttackResponse_Start
-	//	*AttackResponse_Stop
-	//	*AttackResponse_Kill
-	Response      isAttackResponse_Response `protobuf_oneof:"response"`
+func (x *SetupClaim) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *SetupClaim) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *SetupClaim) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+type SetupTurn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           bool                   `protobuf:"varint,1,opt,name=run,proto3" json:"run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackResponse) Reset() {
-	*x = AttackResponse{}
+func (x *SetupTurn) Reset() {
+	*x = SetupTurn{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackResponse) String() string {
+func (x *SetupTurn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackResponse) ProtoMessage() {}
+func (*SetupTurn) ProtoMessage() {}
 
-func (x *AttackResponse) ProtoReflect() protoreflect.Message {
+func (x *SetupTurn) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,92 +269,42 @@ func (x *AttackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackResponse.ProtoReflect.Descriptor instead.
-func (*AttackResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupTurn.ProtoReflect.Descriptor instead.
+func (*SetupTurn) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AttackResponse) GetResponse() isAttackResponse_Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-func (x *AttackResponse) GetStart() *OperationStart {
-	if x != nil {
-		if x, ok := x.Response.(*AttackResponse_Start); ok {
-			return x.Start
-		}
-	}
-	return nil
-}
-
-func (x *AttackResponse) GetStop() *OperationStop {
-	if x != nil {
-		if x, ok := x.Response.(*AttackResponse_Stop); ok {
-			return x.Stop
-		}
-	}
-	return nil
-}
-
-func (x *AttackResponse) GetKill() *OperationKill {
+func (x *SetupTurn) GetRun() bool {
 	if x != nil {
-		if x, ok := x.Response.(*AttackResponse_Kill); ok {
-			return x.Kill
-		}
+		return x.Run
 	}
-	return nil
-}
-
-type isAttackResponse_Response interface {
-	isAttackResponse_Response()
-}
-
-type AttackResponse_Start struct {
-	Start *OperationStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
-}
-
-type AttackResponse_Stop struct {
-	Stop *OperationStop `protobuf:"bytes,2,opt,name=stop,proto3,oneof"`
-}
-
-type AttackResponse_Kill struct {
-	Kill *OperationKill `protobuf:"bytes,3,opt,name=kill,proto3,oneof"`
+	return false
 }
 
-func (*AttackResponse_Start) isAttackResponse_Response() {}
-
-func (*AttackResponse_Stop) isAttackResponse_Response() {}
-
-func (*AttackResponse_Kill) isAttackResponse_Response() {}
-
-type OperationStart struct {
+type SetupResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
 	AttackId      int64                  `protobuf:"varint,2,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
-	IncrementId   int64                  `protobuf:"varint,3,opt,name=increment_id,json=incrementId,proto3" json:"increment_id,omitempty"`
-	WaitTimeSec   float32                `protobuf:"fixed32,4,opt,name=wait_time_sec,json=waitTimeSec,proto3" json:"wait_time_sec,omitempty"`
-	Scenarios     map[string]int64       `protobuf:"bytes,5,rep,name=scenarios,proto3" json:"scenarios,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Scenario      string                 `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Failure       string                 `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationStart) Reset() {
-	*x = OperationStart{}
+func (x *SetupResult) Reset() {
+	*x = SetupResult{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationStart) String() string {
+func (x *SetupResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationStart) ProtoMessage() {}
+func (*SetupResult) ProtoMessage() {}
 
-func (x *OperationStart) ProtoReflect() protoreflect.Message {
+func (x *SetupResult) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,68 +316,59 @@ func (x *OperationStart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationStart.ProtoReflect.Descriptor instead.
-func (*OperationStart) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupResult.ProtoReflect.Descriptor instead.
+func (*SetupResult) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OperationStart) GetId() string {
+func (x *SetupResult) GetNodeName() string {
 	if x != nil {
-		return x.Id
+		return x.NodeName
 	}
 	return ""
 }
 
-func (x *OperationStart) GetAttackId() int64 {
+func (x *SetupResult) GetAttackId() int64 {
 	if x != nil {
 		return x.AttackId
 	}
 	return 0
 }
 
-func (x *OperationStart) GetIncrementId() int64 {
-	if x != nil {
-		return x.IncrementId
-	}
-	return 0
-}
-
-func (x *OperationStart) GetWaitTimeSec() float32 {
+func (x *SetupResult) GetScenario() string {
 	if x != nil {
-		return x.WaitTimeSec
+		return x.Scenario
 	}
-	return 0
+	return ""
 }
 
-func (x *OperationStart) GetScenarios() map[string]int64 {
+func (x *SetupResult) GetFailure() string {
 	if x != nil {
-		return x.Scenarios
+		return x.Failure
 	}
-	return nil
+	return ""
 }
 
-type OperationStop struct {
+type SetupCompletion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttackId      int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
-	IncrementId   *int64                 `protobuf:"varint,2,opt,name=increment_id,json=incrementId,proto3,oneof" json:"increment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationStop) Reset() {
-	*x = OperationStop{}
+func (x *SetupCompletion) Reset() {
+	*x = SetupCompletion{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationStop) String() string {
+func (x *SetupCompletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationStop) ProtoMessage() {}
+func (*SetupCompletion) ProtoMessage() {}
 
-func (x *OperationStop) ProtoReflect() protoreflect.Message {
+func (x *SetupCompletion) ProtoReflect() protoreflect.Message {
 	mi := &file_load_generation_system_v1_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -450,38 +380,1548 @@ func (x *OperationStop) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationStop.ProtoReflect.Descriptor instead.
-func (*OperationStop) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupCompletion.ProtoReflect.Descriptor instead.
+func (*SetupCompletion) Descriptor() ([]byte, []int) {
 	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *OperationStop) GetAttackId() int64 {
-	if x != nil {
-		return x.AttackId
-	}
-	return 0
-}
-
-func (x *OperationStop) GetIncrementId() int64 {
-	if x != nil && x.IncrementId != nil {
-		return *x.IncrementId
-	}
-	return 0
-}
-
-type OperationKill struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type AttackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*AttackRequest_Handshake
+	//	*AttackRequest_Acknowledge
+	//	*AttackRequest_Report
+	Request       isAttackRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationKill) Reset() {
-	*x = OperationKill{}
+func (x *AttackRequest) Reset() {
+	*x = AttackRequest{}
 	mi := &file_load_generation_system_v1_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
+func (x *AttackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackRequest) ProtoMessage() {}
+
+func (x *AttackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackRequest.ProtoReflect.Descriptor instead.
+func (*AttackRequest) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AttackRequest) GetRequest() isAttackRequest_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AttackRequest) GetHandshake() *Handshake {
+	if x != nil {
+		if x, ok := x.Request.(*AttackRequest_Handshake); ok {
+			return x.Handshake
+		}
+	}
+	return nil
+}
+
+func (x *AttackRequest) GetAcknowledge() *Acknowledge {
+	if x != nil {
+		if x, ok := x.Request.(*AttackRequest_Acknowledge); ok {
+			return x.Acknowledge
+		}
+	}
+	return nil
+}
+
+func (x *AttackRequest) GetReport() *Report {
+	if x != nil {
+		if x, ok := x.Request.(*AttackRequest_Report); ok {
+			return x.Report
+		}
+	}
+	return nil
+}
+
+type isAttackRequest_Request interface {
+	isAttackRequest_Request()
+}
+
+type AttackRequest_Handshake struct {
+	Handshake *Handshake `protobuf:"bytes,1,opt,name=handshake,proto3,oneof"`
+}
+
+type AttackRequest_Acknowledge struct {
+	Acknowledge *Acknowledge `protobuf:"bytes,2,opt,name=acknowledge,proto3,oneof"`
+}
+
+type AttackRequest_Report struct {
+	Report *Report `protobuf:"bytes,3,opt,name=report,proto3,oneof"`
+}
+
+func (*AttackRequest_Handshake) isAttackRequest_Request() {}
+
+func (*AttackRequest_Acknowledge) isAttackRequest_Request() {}
+
+func (*AttackRequest_Report) isAttackRequest_Request() {}
+
+type Handshake struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Scenarios     []*Scenario            `protobuf:"bytes,2,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	InstanceId    string                 `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Handshake) Reset() {
+	*x = Handshake{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handshake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handshake) ProtoMessage() {}
+
+func (x *Handshake) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handshake.ProtoReflect.Descriptor instead.
+func (*Handshake) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Handshake) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *Handshake) GetScenarios() []*Scenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+func (x *Handshake) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+type Scenario struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Parameters    []*ScenarioParameter   `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Scenario) GetParameters() []*ScenarioParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ScenarioParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Default       []byte                 `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioParameter) Reset() {
+	*x = ScenarioParameter{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioParameter) ProtoMessage() {}
+
+func (x *ScenarioParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioParameter.ProtoReflect.Descriptor instead.
+func (*ScenarioParameter) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScenarioParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScenarioParameter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScenarioParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScenarioParameter) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ScenarioParameter) GetDefault() []byte {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+type Acknowledge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Acknowledge) Reset() {
+	*x = Acknowledge{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Acknowledge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Acknowledge) ProtoMessage() {}
+
+func (x *Acknowledge) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Acknowledge.ProtoReflect.Descriptor instead.
+func (*Acknowledge) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{11}
+}
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*AttackStats         `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Completions   []*IncrementCompletion `protobuf:"bytes,2,rep,name=completions,proto3" json:"completions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Report) GetStats() []*AttackStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Report) GetCompletions() []*IncrementCompletion {
+	if x != nil {
+		return x.Completions
+	}
+	return nil
+}
+
+type AttackStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackId      int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	Bounds        []float64              `protobuf:"fixed64,4,rep,packed,name=bounds,proto3" json:"bounds,omitempty"`
+	Buckets       []int64                `protobuf:"varint,5,rep,packed,name=buckets,proto3" json:"buckets,omitempty"`
+	Checks        map[string]*CheckStats `protobuf:"bytes,6,rep,name=checks,proto3" json:"checks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SetupFailures int64                  `protobuf:"varint,7,opt,name=setup_failures,json=setupFailures,proto3" json:"setup_failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackStats) Reset() {
+	*x = AttackStats{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackStats) ProtoMessage() {}
+
+func (x *AttackStats) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackStats.ProtoReflect.Descriptor instead.
+func (*AttackStats) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AttackStats) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *AttackStats) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *AttackStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *AttackStats) GetBounds() []float64 {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *AttackStats) GetBuckets() []int64 {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *AttackStats) GetChecks() map[string]*CheckStats {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *AttackStats) GetSetupFailures() int64 {
+	if x != nil {
+		return x.SetupFailures
+	}
+	return 0
+}
+
+type CheckStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passed        int64                  `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed        int64                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStats) Reset() {
+	*x = CheckStats{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStats) ProtoMessage() {}
+
+func (x *CheckStats) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStats.ProtoReflect.Descriptor instead.
+func (*CheckStats) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckStats) GetPassed() int64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *CheckStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type IncrementCompletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackId      int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	IncrementId   int64                  `protobuf:"varint,2,opt,name=increment_id,json=incrementId,proto3" json:"increment_id,omitempty"`
+	Iterations    int64                  `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementCompletion) Reset() {
+	*x = IncrementCompletion{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementCompletion) ProtoMessage() {}
+
+func (x *IncrementCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementCompletion.ProtoReflect.Descriptor instead.
+func (*IncrementCompletion) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IncrementCompletion) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *IncrementCompletion) GetIncrementId() int64 {
+	if x != nil {
+		return x.IncrementId
+	}
+	return 0
+}
+
+func (x *IncrementCompletion) GetIterations() int64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+type AttackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*AttackResponse_Start
+	//	*AttackResponse_Stop
+	//	*AttackResponse_Kill
+	//	*AttackResponse_Reduce
+	//	*AttackResponse_Pause
+	//	*AttackResponse_Resume
+	//	*AttackResponse_Scenario
+	//	*AttackResponse_Rescale
+	Response      isAttackResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackResponse) Reset() {
+	*x = AttackResponse{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackResponse) ProtoMessage() {}
+
+func (x *AttackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackResponse.ProtoReflect.Descriptor instead.
+func (*AttackResponse) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AttackResponse) GetResponse() isAttackResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetStart() *OperationStart {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetStop() *OperationStop {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Stop); ok {
+			return x.Stop
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetKill() *OperationKill {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Kill); ok {
+			return x.Kill
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetReduce() *OperationReduce {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Reduce); ok {
+			return x.Reduce
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetPause() *OperationPause {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Pause); ok {
+			return x.Pause
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetResume() *OperationResume {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Resume); ok {
+			return x.Resume
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetScenario() *OperationScenario {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Scenario); ok {
+			return x.Scenario
+		}
+	}
+	return nil
+}
+
+func (x *AttackResponse) GetRescale() *OperationRescale {
+	if x != nil {
+		if x, ok := x.Response.(*AttackResponse_Rescale); ok {
+			return x.Rescale
+		}
+	}
+	return nil
+}
+
+type isAttackResponse_Response interface {
+	isAttackResponse_Response()
+}
+
+type AttackResponse_Start struct {
+	Start *OperationStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type AttackResponse_Stop struct {
+	Stop *OperationStop `protobuf:"bytes,2,opt,name=stop,proto3,oneof"`
+}
+
+type AttackResponse_Kill struct {
+	Kill *OperationKill `protobuf:"bytes,3,opt,name=kill,proto3,oneof"`
+}
+
+type AttackResponse_Reduce struct {
+	Reduce *OperationReduce `protobuf:"bytes,4,opt,name=reduce,proto3,oneof"`
+}
+
+type AttackResponse_Pause struct {
+	Pause *OperationPause `protobuf:"bytes,5,opt,name=pause,proto3,oneof"`
+}
+
+type AttackResponse_Resume struct {
+	Resume *OperationResume `protobuf:"bytes,6,opt,name=resume,proto3,oneof"`
+}
+
+type AttackResponse_Scenario struct {
+	Scenario *OperationScenario `protobuf:"bytes,7,opt,name=scenario,proto3,oneof"`
+}
+
+type AttackResponse_Rescale struct {
+	Rescale *OperationRescale `protobuf:"bytes,8,opt,name=rescale,proto3,oneof"`
+}
+
+func (*AttackResponse_Start) isAttackResponse_Response() {}
+
+func (*AttackResponse_Stop) isAttackResponse_Response() {}
+
+func (*AttackResponse_Kill) isAttackResponse_Response() {}
+
+func (*AttackResponse_Reduce) isAttackResponse_Response() {}
+
+func (*AttackResponse_Pause) isAttackResponse_Response() {}
+
+func (*AttackResponse_Resume) isAttackResponse_Response() {}
+
+func (*AttackResponse_Scenario) isAttackResponse_Response() {}
+
+func (*AttackResponse_Rescale) isAttackResponse_Response() {}
+
+type OperationStart struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttackId          int64                   `protobuf:"varint,2,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	IncrementId       int64                   `protobuf:"varint,3,opt,name=increment_id,json=incrementId,proto3" json:"increment_id,omitempty"`
+	WaitTimeSec       float32                 `protobuf:"fixed32,4,opt,name=wait_time_sec,json=waitTimeSec,proto3" json:"wait_time_sec,omitempty"`
+	Scenarios         map[string]int64        `protobuf:"bytes,5,rep,name=scenarios,proto3" json:"scenarios,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ArrivalRates      map[string]*ArrivalRate `protobuf:"bytes,6,rep,name=arrival_rates,json=arrivalRates,proto3" json:"arrival_rates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Iterations        *int64                  `protobuf:"varint,7,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`
+	IterationsPerUser int64                   `protobuf:"varint,8,opt,name=iterations_per_user,json=iterationsPerUser,proto3" json:"iterations_per_user,omitempty"`
+	Pacings           map[string]*Pacing      `protobuf:"bytes,9,rep,name=pacings,proto3" json:"pacings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Target            *Target                 `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
+	Params            map[string][]byte       `protobuf:"bytes,11,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Feeds             []*FeedShare            `protobuf:"bytes,12,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OperationStart) Reset() {
+	*x = OperationStart{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationStart) ProtoMessage() {}
+
+func (x *OperationStart) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationStart.ProtoReflect.Descriptor instead.
+func (*OperationStart) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OperationStart) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OperationStart) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *OperationStart) GetIncrementId() int64 {
+	if x != nil {
+		return x.IncrementId
+	}
+	return 0
+}
+
+func (x *OperationStart) GetWaitTimeSec() float32 {
+	if x != nil {
+		return x.WaitTimeSec
+	}
+	return 0
+}
+
+func (x *OperationStart) GetScenarios() map[string]int64 {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+func (x *OperationStart) GetArrivalRates() map[string]*ArrivalRate {
+	if x != nil {
+		return x.ArrivalRates
+	}
+	return nil
+}
+
+func (x *OperationStart) GetIterations() int64 {
+	if x != nil && x.Iterations != nil {
+		return *x.Iterations
+	}
+	return 0
+}
+
+func (x *OperationStart) GetIterationsPerUser() int64 {
+	if x != nil {
+		return x.IterationsPerUser
+	}
+	return 0
+}
+
+func (x *OperationStart) GetPacings() map[string]*Pacing {
+	if x != nil {
+		return x.Pacings
+	}
+	return nil
+}
+
+func (x *OperationStart) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *OperationStart) GetParams() map[string][]byte {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *OperationStart) GetFeeds() []*FeedShare {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+type FeedShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dataset       string                 `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Rows          []*DataRow             `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedShare) Reset() {
+	*x = FeedShare{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedShare) ProtoMessage() {}
+
+func (x *FeedShare) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedShare.ProtoReflect.Descriptor instead.
+func (*FeedShare) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FeedShare) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *FeedShare) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *FeedShare) GetRows() []*DataRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type DataRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]string      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataRow) Reset() {
+	*x = DataRow{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRow) ProtoMessage() {}
+
+func (x *DataRow) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRow.ProtoReflect.Descriptor instead.
+func (*DataRow) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DataRow) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Target struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Services      map[string]string      `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Target) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Target) GetServices() map[string]string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Target) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type Pacing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	IntervalSec   float64                `protobuf:"fixed64,2,opt,name=interval_sec,json=intervalSec,proto3" json:"interval_sec,omitempty"`
+	MinSec        float64                `protobuf:"fixed64,3,opt,name=min_sec,json=minSec,proto3" json:"min_sec,omitempty"`
+	MaxSec        float64                `protobuf:"fixed64,4,opt,name=max_sec,json=maxSec,proto3" json:"max_sec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pacing) Reset() {
+	*x = Pacing{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pacing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pacing) ProtoMessage() {}
+
+func (x *Pacing) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pacing.ProtoReflect.Descriptor instead.
+func (*Pacing) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Pacing) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Pacing) GetIntervalSec() float64 {
+	if x != nil {
+		return x.IntervalSec
+	}
+	return 0
+}
+
+func (x *Pacing) GetMinSec() float64 {
+	if x != nil {
+		return x.MinSec
+	}
+	return 0
+}
+
+func (x *Pacing) GetMaxSec() float64 {
+	if x != nil {
+		return x.MaxSec
+	}
+	return 0
+}
+
+type ArrivalRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          float64                `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	TargetRate    float64                `protobuf:"fixed64,2,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`
+	RampUpSec     int64                  `protobuf:"varint,3,opt,name=ramp_up_sec,json=rampUpSec,proto3" json:"ramp_up_sec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArrivalRate) Reset() {
+	*x = ArrivalRate{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArrivalRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArrivalRate) ProtoMessage() {}
+
+func (x *ArrivalRate) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArrivalRate.ProtoReflect.Descriptor instead.
+func (*ArrivalRate) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArrivalRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ArrivalRate) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *ArrivalRate) GetRampUpSec() int64 {
+	if x != nil {
+		return x.RampUpSec
+	}
+	return 0
+}
+
+type OperationStop struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AttackId        int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	IncrementId     *int64                 `protobuf:"varint,2,opt,name=increment_id,json=incrementId,proto3,oneof" json:"increment_id,omitempty"`
+	DrainTimeoutSec *int64                 `protobuf:"varint,3,opt,name=drain_timeout_sec,json=drainTimeoutSec,proto3,oneof" json:"drain_timeout_sec,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OperationStop) Reset() {
+	*x = OperationStop{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationStop) ProtoMessage() {}
+
+func (x *OperationStop) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationStop.ProtoReflect.Descriptor instead.
+func (*OperationStop) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OperationStop) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *OperationStop) GetIncrementId() int64 {
+	if x != nil && x.IncrementId != nil {
+		return *x.IncrementId
+	}
+	return 0
+}
+
+func (x *OperationStop) GetDrainTimeoutSec() int64 {
+	if x != nil && x.DrainTimeoutSec != nil {
+		return *x.DrainTimeoutSec
+	}
+	return 0
+}
+
+type OperationReduce struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackId      int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	IncrementId   int64                  `protobuf:"varint,2,opt,name=increment_id,json=incrementId,proto3" json:"increment_id,omitempty"`
+	Scenarios     map[string]int64       `protobuf:"bytes,3,rep,name=scenarios,proto3" json:"scenarios,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationReduce) Reset() {
+	*x = OperationReduce{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationReduce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationReduce) ProtoMessage() {}
+
+func (x *OperationReduce) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationReduce.ProtoReflect.Descriptor instead.
+func (*OperationReduce) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OperationReduce) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *OperationReduce) GetIncrementId() int64 {
+	if x != nil {
+		return x.IncrementId
+	}
+	return 0
+}
+
+func (x *OperationReduce) GetScenarios() map[string]int64 {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+type OperationRescale struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	AttackId      int64                   `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	IncrementId   int64                   `protobuf:"varint,2,opt,name=increment_id,json=incrementId,proto3" json:"increment_id,omitempty"`
+	ArrivalRates  map[string]*ArrivalRate `protobuf:"bytes,3,rep,name=arrival_rates,json=arrivalRates,proto3" json:"arrival_rates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationRescale) Reset() {
+	*x = OperationRescale{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationRescale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRescale) ProtoMessage() {}
+
+func (x *OperationRescale) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRescale.ProtoReflect.Descriptor instead.
+func (*OperationRescale) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OperationRescale) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+func (x *OperationRescale) GetIncrementId() int64 {
+	if x != nil {
+		return x.IncrementId
+	}
+	return 0
+}
+
+func (x *OperationRescale) GetArrivalRates() map[string]*ArrivalRate {
+	if x != nil {
+		return x.ArrivalRates
+	}
+	return nil
+}
+
+type OperationPause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackId      int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationPause) Reset() {
+	*x = OperationPause{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationPause) ProtoMessage() {}
+
+func (x *OperationPause) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationPause.ProtoReflect.Descriptor instead.
+func (*OperationPause) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OperationPause) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+type OperationResume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackId      int64                  `protobuf:"varint,1,opt,name=attack_id,json=attackId,proto3" json:"attack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationResume) Reset() {
+	*x = OperationResume{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationResume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationResume) ProtoMessage() {}
+
+func (x *OperationResume) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationResume.ProtoReflect.Descriptor instead.
+func (*OperationResume) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OperationResume) GetAttackId() int64 {
+	if x != nil {
+		return x.AttackId
+	}
+	return 0
+}
+
+type OperationScenario struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Definition    []byte                 `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationScenario) Reset() {
+	*x = OperationScenario{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationScenario) ProtoMessage() {}
+
+func (x *OperationScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_load_generation_system_v1_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationScenario.ProtoReflect.Descriptor instead.
+func (*OperationScenario) Descriptor() ([]byte, []int) {
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OperationScenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationScenario) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OperationScenario) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OperationScenario) GetDefinition() []byte {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+type OperationKill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationKill) Reset() {
+	*x = OperationKill{}
+	mi := &file_load_generation_system_v1_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
 func (x *OperationKill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
@@ -489,7 +1929,7 @@ func (x *OperationKill) String() string {
 func (*OperationKill) ProtoMessage() {}
 
 func (x *OperationKill) ProtoReflect() protoreflect.Message {
-	mi := &file_load_generation_system_v1_proto_msgTypes[7]
+	mi := &file_load_generation_system_v1_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +1942,7 @@ func (x *OperationKill) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationKill.ProtoReflect.Descriptor instead.
 func (*OperationKill) Descriptor() ([]byte, []int) {
-	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{7}
+	return file_load_generation_system_v1_proto_rawDescGZIP(), []int{29}
 }
 
 var File_load_generation_system_v1_proto protoreflect.FileDescriptor
@@ -511,77 +1951,364 @@ var file_load_generation_system_v1_proto_rawDesc = string([]byte{
 	0x0a, 0x1f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x19, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x22, 0xac, 0x01, 0x0a,
-	0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44,
-	0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x61,
-	0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x73,
-	0x68, 0x61, 0x6b, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x64,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x22, 0x60, 0x0a, 0x0c,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4c,
+	0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x0e, 0x0a, 0x0c,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x0a,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x22, 0x1d, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x22,
+	0x7d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x11,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xe9, 0x01, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x61, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8c, 0x01,
+	0x0a, 0x09, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f,
+	0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a,
+	0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x93, 0x01,
+	0x0a, 0x11, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe5, 0x02,
+	0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x4a, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x65, 0x74, 0x75, 0x70, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x13, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc3, 0x04, 0x0a, 0x0e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x3e, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70,
+	0x12, 0x3e, 0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6c, 0x6c,
+	0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x6f, 0x61, 0x64,
 	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x09, 0x48,
-	0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12,
+	0x4a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x47, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xfb, 0x07, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x56, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61,
+	0x72, 0x69, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x12,
+	0x60, 0x0a, 0x0d, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x2e, 0x50, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
 	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x09, 0x73,
-	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x22, 0x40, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e,
-	0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x0e, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x6f,
-	0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x3e, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x67, 0x0a, 0x11,
+	0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x71,
+	0x0a, 0x09, 0x46, 0x65, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x5f, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x52, 0x6f, 0x77, 0x12, 0x46, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
 	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x12,
-	0x3e, 0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x6f,
+	0x77, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xac, 0x02, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x4b, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
 	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x0e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69,
-	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22,
-	0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x65, 0x63, 0x12, 0x56, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x63,
-	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x65, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x71, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53,
+	0x65, 0x63, 0x22, 0x62, 0x0a, 0x0b, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x75,
+	0x70, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x61, 0x6d,
+	0x70, 0x55, 0x70, 0x53, 0x65, 0x63, 0x22, 0xac, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0f, 0x64, 0x72, 0x61, 0x69,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x22, 0xe8, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74,
 	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74,
-	0x74, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b,
-	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
-	0x0f, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6c, 0x6c,
-	0x32, 0x71, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x67, 0x0a, 0x0c, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65,
+	0x74, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x09, 0x73, 0x63, 0x65,
+	0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
+	0x6f, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x9f, 0x02, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x62, 0x0a, 0x0d, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x67, 0x0a, 0x11, 0x41, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x2d, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x49,
+	0x64, 0x22, 0x2e, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x49,
+	0x64, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6c, 0x6c, 0x32, 0x71, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x12, 0x67, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x12, 0x28, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x32, 0xc7, 0x01, 0x0a, 0x05,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0c, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x27, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x1a, 0x25,
+	0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x1a, 0x27, 0x2e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x32, 0xc7, 0x01, 0x0a, 0x05, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12,
+	0x59, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x25, 0x2e,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31,
-	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
-	0x01, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -596,33 +2323,97 @@ func file_load_generation_system_v1_proto_rawDescGZIP() []byte {
 	return file_load_generation_system_v1_proto_rawDescData
 }
 
-var file_load_generation_system_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_load_generation_system_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_load_generation_system_v1_proto_goTypes = []any{
-	(*AttackRequest)(nil),  // 0: load_generation_system_v1.AttackRequest
-	(*Handshake)(nil),      // 1: load_generation_system_v1.Handshake
-	(*Scenario)(nil),       // 2: load_generation_system_v1.Scenario
-	(*Acknowledge)(nil),    // 3: load_generation_system_v1.Acknowledge
-	(*AttackResponse)(nil), // 4: load_generation_system_v1.AttackResponse
-	(*OperationStart)(nil), // 5: load_generation_system_v1.OperationStart
-	(*OperationStop)(nil),  // 6: load_generation_system_v1.OperationStop
-	(*OperationKill)(nil),  // 7: load_generation_system_v1.OperationKill
-	nil,                    // 8: load_generation_system_v1.OperationStart.ScenariosEntry
+	(*LeaseAcquire)(nil),        // 0: load_generation_system_v1.LeaseAcquire
+	(*LeaseGrant)(nil),          // 1: load_generation_system_v1.LeaseGrant
+	(*LeaseRelease)(nil),        // 2: load_generation_system_v1.LeaseRelease
+	(*SetupClaim)(nil),          // 3: load_generation_system_v1.SetupClaim
+	(*SetupTurn)(nil),           // 4: load_generation_system_v1.SetupTurn
+	(*SetupResult)(nil),         // 5: load_generation_system_v1.SetupResult
+	(*SetupCompletion)(nil),     // 6: load_generation_system_v1.SetupCompletion
+	(*AttackRequest)(nil),       // 7: load_generation_system_v1.AttackRequest
+	(*Handshake)(nil),           // 8: load_generation_system_v1.Handshake
+	(*Scenario)(nil),            // 9: load_generation_system_v1.Scenario
+	(*ScenarioParameter)(nil),   // 10: load_generation_system_v1.ScenarioParameter
+	(*Acknowledge)(nil),         // 11: load_generation_system_v1.Acknowledge
+	(*Report)(nil),              // 12: load_generation_system_v1.Report
+	(*AttackStats)(nil),         // 13: load_generation_system_v1.AttackStats
+	(*CheckStats)(nil),          // 14: load_generation_system_v1.CheckStats
+	(*IncrementCompletion)(nil), // 15: load_generation_system_v1.IncrementCompletion
+	(*AttackResponse)(nil),      // 16: load_generation_system_v1.AttackResponse
+	(*OperationStart)(nil),      // 17: load_generation_system_v1.OperationStart
+	(*FeedShare)(nil),           // 18: load_generation_system_v1.FeedShare
+	(*DataRow)(nil),             // 19: load_generation_system_v1.DataRow
+	(*Target)(nil),              // 20: load_generation_system_v1.Target
+	(*Pacing)(nil),              // 21: load_generation_system_v1.Pacing
+	(*ArrivalRate)(nil),         // 22: load_generation_system_v1.ArrivalRate
+	(*OperationStop)(nil),       // 23: load_generation_system_v1.OperationStop
+	(*OperationReduce)(nil),     // 24: load_generation_system_v1.OperationReduce
+	(*OperationRescale)(nil),    // 25: load_generation_system_v1.OperationRescale
+	(*OperationPause)(nil),      // 26: load_generation_system_v1.OperationPause
+	(*OperationResume)(nil),     // 27: load_generation_system_v1.OperationResume
+	(*OperationScenario)(nil),   // 28: load_generation_system_v1.OperationScenario
+	(*OperationKill)(nil),       // 29: load_generation_system_v1.OperationKill
+	nil,                         // 30: load_generation_system_v1.AttackStats.ChecksEntry
+	nil,                         // 31: load_generation_system_v1.OperationStart.ScenariosEntry
+	nil,                         // 32: load_generation_system_v1.OperationStart.ArrivalRatesEntry
+	nil,                         // 33: load_generation_system_v1.OperationStart.PacingsEntry
+	nil,                         // 34: load_generation_system_v1.OperationStart.ParamsEntry
+	nil,                         // 35: load_generation_system_v1.DataRow.ValuesEntry
+	nil,                         // 36: load_generation_system_v1.Target.ServicesEntry
+	nil,                         // 37: load_generation_system_v1.Target.HeadersEntry
+	nil,                         // 38: load_generation_system_v1.OperationReduce.ScenariosEntry
+	nil,                         // 39: load_generation_system_v1.OperationRescale.ArrivalRatesEntry
 }
 var file_load_generation_system_v1_proto_depIdxs = []int32{
-	1, // 0: load_generation_system_v1.AttackRequest.handshake:type_name -> load_generation_system_v1.Handshake
-	3, // 1: load_generation_system_v1.AttackRequest.acknowledge:type_name -> load_generation_system_v1.Acknowledge
-	2, // 2: load_generation_system_v1.Handshake.scenarios:type_name -> load_generation_system_v1.Scenario
-	5, // 3: load_generation_system_v1.AttackResponse.start:type_name -> load_generation_system_v1.OperationStart
-	6, // 4: load_generation_system_v1.AttackResponse.stop:type_name -> load_generation_system_v1.OperationStop
-	7, // 5: load_generation_system_v1.AttackResponse.kill:type_name -> load_generation_system_v1.OperationKill
-	8, // 6: load_generation_system_v1.OperationStart.scenarios:type_name -> load_generation_system_v1.OperationStart.ScenariosEntry
-	0, // 7: load_generation_system_v1.Attack.StreamAttack:input_type -> load_generation_system_v1.AttackRequest
-	4, // 8: load_generation_system_v1.Attack.StreamAttack:output_type -> load_generation_system_v1.AttackResponse
-	8, // [8:9] is the sub-list for method output_type
-	7, // [7:8] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	8,  // 0: load_generation_system_v1.AttackRequest.handshake:type_name -> load_generation_system_v1.Handshake
+	11, // 1: load_generation_system_v1.AttackRequest.acknowledge:type_name -> load_generation_system_v1.Acknowledge
+	12, // 2: load_generation_system_v1.AttackRequest.report:type_name -> load_generation_system_v1.Report
+	9,  // 3: load_generation_system_v1.Handshake.scenarios:type_name -> load_generation_system_v1.Scenario
+	10, // 4: load_generation_system_v1.Scenario.parameters:type_name -> load_generation_system_v1.ScenarioParameter
+	13, // 5: load_generation_system_v1.Report.stats:type_name -> load_generation_system_v1.AttackStats
+	15, // 6: load_generation_system_v1.Report.completions:type_name -> load_generation_system_v1.IncrementCompletion
+	30, // 7: load_generation_system_v1.AttackStats.checks:type_name -> load_generation_system_v1.AttackStats.ChecksEntry
+	17, // 8: load_generation_system_v1.AttackResponse.start:type_name -> load_generation_system_v1.OperationStart
+	23, // 9: load_generation_system_v1.AttackResponse.stop:type_name -> load_generation_system_v1.OperationStop
+	29, // 10: load_generation_system_v1.AttackResponse.kill:type_name -> load_generation_system_v1.OperationKill
+	24, // 11: load_generation_system_v1.AttackResponse.reduce:type_name -> load_generation_system_v1.OperationReduce
+	26, // 12: load_generation_system_v1.AttackResponse.pause:type_name -> load_generation_system_v1.OperationPause
+	27, // 13: load_generation_system_v1.AttackResponse.resume:type_name -> load_generation_system_v1.OperationResume
+	28, // 14: load_generation_system_v1.AttackResponse.scenario:type_name -> load_generation_system_v1.OperationScenario
+	25, // 15: load_generation_system_v1.AttackResponse.rescale:type_name -> load_generation_system_v1.OperationRescale
+	31, // 16: load_generation_system_v1.OperationStart.scenarios:type_name -> load_generation_system_v1.OperationStart.ScenariosEntry
+	32, // 17: load_generation_system_v1.OperationStart.arrival_rates:type_name -> load_generation_system_v1.OperationStart.ArrivalRatesEntry
+	33, // 18: load_generation_system_v1.OperationStart.pacings:type_name -> load_generation_system_v1.OperationStart.PacingsEntry
+	20, // 19: load_generation_system_v1.OperationStart.target:type_name -> load_generation_system_v1.Target
+	34, // 20: load_generation_system_v1.OperationStart.params:type_name -> load_generation_system_v1.OperationStart.ParamsEntry
+	18, // 21: load_generation_system_v1.OperationStart.feeds:type_name -> load_generation_system_v1.FeedShare
+	19, // 22: load_generation_system_v1.FeedShare.rows:type_name -> load_generation_system_v1.DataRow
+	35, // 23: load_generation_system_v1.DataRow.values:type_name -> load_generation_system_v1.DataRow.ValuesEntry
+	36, // 24: load_generation_system_v1.Target.services:type_name -> load_generation_system_v1.Target.ServicesEntry
+	37, // 25: load_generation_system_v1.Target.headers:type_name -> load_generation_system_v1.Target.HeadersEntry
+	38, // 26: load_generation_system_v1.OperationReduce.scenarios:type_name -> load_generation_system_v1.OperationReduce.ScenariosEntry
+	39, // 27: load_generation_system_v1.OperationRescale.arrival_rates:type_name -> load_generation_system_v1.OperationRescale.ArrivalRatesEntry
+	14, // 28: load_generation_system_v1.AttackStats.ChecksEntry.value:type_name -> load_generation_system_v1.CheckStats
+	22, // 29: load_generation_system_v1.OperationStart.ArrivalRatesEntry.value:type_name -> load_generation_system_v1.ArrivalRate
+	21, // 30: load_generation_system_v1.OperationStart.PacingsEntry.value:type_name -> load_generation_system_v1.Pacing
+	22, // 31: load_generation_system_v1.OperationRescale.ArrivalRatesEntry.value:type_name -> load_generation_system_v1.ArrivalRate
+	7,  // 32: load_generation_system_v1.Attack.StreamAttack:input_type -> load_generation_system_v1.AttackRequest
+	0,  // 33: load_generation_system_v1.Lease.AcquireLease:input_type -> load_generation_system_v1.LeaseAcquire
+	1,  // 34: load_generation_system_v1.Lease.ReleaseLease:input_type -> load_generation_system_v1.LeaseGrant
+	3,  // 35: load_generation_system_v1.Setup.ClaimSetup:input_type -> load_generation_system_v1.SetupClaim
+	5,  // 36: load_generation_system_v1.Setup.CompleteSetup:input_type -> load_generation_system_v1.SetupResult
+	16, // 37: load_generation_system_v1.Attack.StreamAttack:output_type -> load_generation_system_v1.AttackResponse
+	1,  // 38: load_generation_system_v1.Lease.AcquireLease:output_type -> load_generation_system_v1.LeaseGrant
+	2,  // 39: load_generation_system_v1.Lease.ReleaseLease:output_type -> load_generation_system_v1.LeaseRelease
+	4,  // 40: load_generation_system_v1.Setup.ClaimSetup:output_type -> load_generation_system_v1.SetupTurn
+	6,  // 41: load_generation_system_v1.Setup.CompleteSetup:output_type -> load_generation_system_v1.SetupCompletion
+	37, // [37:42] is the sub-list for method output_type
+	32, // [32:37] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_load_generation_system_v1_proto_init() }
@@ -630,25 +2421,32 @@ func file_load_generation_system_v1_proto_init() {
 	if File_load_generation_system_v1_proto != nil {
 		return
 	}
-	file_load_generation_system_v1_proto_msgTypes[0].OneofWrappers = []any{
+	file_load_generation_system_v1_proto_msgTypes[7].OneofWrappers = []any{
 		(*AttackRequest_Handshake)(nil),
 		(*AttackRequest_Acknowledge)(nil),
+		(*AttackRequest_Report)(nil),
 	}
-	file_load_generation_system_v1_proto_msgTypes[4].OneofWrappers = []any{
+	file_load_generation_system_v1_proto_msgTypes[16].OneofWrappers = []any{
 		(*AttackResponse_Start)(nil),
 		(*AttackResponse_Stop)(nil),
 		(*AttackResponse_Kill)(nil),
+		(*AttackResponse_Reduce)(nil),
+		(*AttackResponse_Pause)(nil),
+		(*AttackResponse_Resume)(nil),
+		(*AttackResponse_Scenario)(nil),
+		(*AttackResponse_Rescale)(nil),
 	}
-	file_load_generation_system_v1_proto_msgTypes[6].OneofWrappers = []any{}
+	file_load_generation_system_v1_proto_msgTypes[17].OneofWrappers = []any{}
+	file_load_generation_system_v1_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_load_generation_system_v1_proto_rawDesc), len(file_load_generation_system_v1_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   40,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_load_generation_system_v1_proto_goTypes,
 		DependencyIndexes: file_load_generation_system_v1_proto_depIdxs,
@@ -113,3 +113,283 @@ var Attack_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "load_generation_system_v1.proto",
 }
+
+const (
+	Lease_AcquireLease_FullMethodName = "/load_generation_system_v1.Lease/AcquireLease"
+	Lease_ReleaseLease_FullMethodName = "/load_generation_system_v1.Lease/ReleaseLease"
+)
+
+// LeaseClient is the client API for Lease service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaseClient interface {
+	AcquireLease(ctx context.Context, in *LeaseAcquire, opts ...grpc.CallOption) (*LeaseGrant, error)
+	ReleaseLease(ctx context.Context, in *LeaseGrant, opts ...grpc.CallOption) (*LeaseRelease, error)
+}
+
+type leaseClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaseClient(cc grpc.ClientConnInterface) LeaseClient {
+	return &leaseClient{cc}
+}
+
+func (c *leaseClient) AcquireLease(ctx context.Context, in *LeaseAcquire, opts ...grpc.CallOption) (*LeaseGrant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaseGrant)
+	err := c.cc.Invoke(ctx, Lease_AcquireLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseClient) ReleaseLease(ctx context.Context, in *LeaseGrant, opts ...grpc.CallOption) (*LeaseRelease, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaseRelease)
+	err := c.cc.Invoke(ctx, Lease_ReleaseLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeaseServer is the server API for Lease service.
+// All implementations must embed UnimplementedLeaseServer
+// for forward compatibility.
+type LeaseServer interface {
+	AcquireLease(context.Context, *LeaseAcquire) (*LeaseGrant, error)
+	ReleaseLease(context.Context, *LeaseGrant) (*LeaseRelease, error)
+	mustEmbedUnimplementedLeaseServer()
+}
+
+// UnimplementedLeaseServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLeaseServer struct{}
+
+func (UnimplementedLeaseServer) AcquireLease(context.Context, *LeaseAcquire) (*LeaseGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedLeaseServer) ReleaseLease(context.Context, *LeaseGrant) (*LeaseRelease, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
+func (UnimplementedLeaseServer) mustEmbedUnimplementedLeaseServer() {}
+func (UnimplementedLeaseServer) testEmbeddedByValue()               {}
+
+// UnsafeLeaseServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaseServer will
+// result in compilation errors.
+type UnsafeLeaseServer interface {
+	mustEmbedUnimplementedLeaseServer()
+}
+
+func RegisterLeaseServer(s grpc.ServiceRegistrar, srv LeaseServer) {
+	// If the following call pancis, it indicates UnimplementedLeaseServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Lease_ServiceDesc, srv)
+}
+
+func _Lease_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseAcquire)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lease_AcquireLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).AcquireLease(ctx, req.(*LeaseAcquire))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lease_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lease_ReleaseLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).ReleaseLease(ctx, req.(*LeaseGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Lease_ServiceDesc is the grpc.ServiceDesc for Lease service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Lease_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "load_generation_system_v1.Lease",
+	HandlerType: (*LeaseServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcquireLease",
+			Handler:    _Lease_AcquireLease_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _Lease_ReleaseLease_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "load_generation_system_v1.proto",
+}
+
+const (
+	Setup_ClaimSetup_FullMethodName    = "/load_generation_system_v1.Setup/ClaimSetup"
+	Setup_CompleteSetup_FullMethodName = "/load_generation_system_v1.Setup/CompleteSetup"
+)
+
+// SetupClient is the client API for Setup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SetupClient interface {
+	ClaimSetup(ctx context.Context, in *SetupClaim, opts ...grpc.CallOption) (*SetupTurn, error)
+	CompleteSetup(ctx context.Context, in *SetupResult, opts ...grpc.CallOption) (*SetupCompletion, error)
+}
+
+type setupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSetupClient(cc grpc.ClientConnInterface) SetupClient {
+	return &setupClient{cc}
+}
+
+func (c *setupClient) ClaimSetup(ctx context.Context, in *SetupClaim, opts ...grpc.CallOption) (*SetupTurn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupTurn)
+	err := c.cc.Invoke(ctx, Setup_ClaimSetup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *setupClient) CompleteSetup(ctx context.Context, in *SetupResult, opts ...grpc.CallOption) (*SetupCompletion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupCompletion)
+	err := c.cc.Invoke(ctx, Setup_CompleteSetup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetupServer is the server API for Setup service.
+// All implementations must embed UnimplementedSetupServer
+// for forward compatibility.
+type SetupServer interface {
+	ClaimSetup(context.Context, *SetupClaim) (*SetupTurn, error)
+	CompleteSetup(context.Context, *SetupResult) (*SetupCompletion, error)
+	mustEmbedUnimplementedSetupServer()
+}
+
+// UnimplementedSetupServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSetupServer struct{}
+
+func (UnimplementedSetupServer) ClaimSetup(context.Context, *SetupClaim) (*SetupTurn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimSetup not implemented")
+}
+func (UnimplementedSetupServer) CompleteSetup(context.Context, *SetupResult) (*SetupCompletion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSetup not implemented")
+}
+func (UnimplementedSetupServer) mustEmbedUnimplementedSetupServer() {}
+func (UnimplementedSetupServer) testEmbeddedByValue()               {}
+
+// UnsafeSetupServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SetupServer will
+// result in compilation errors.
+type UnsafeSetupServer interface {
+	mustEmbedUnimplementedSetupServer()
+}
+
+func RegisterSetupServer(s grpc.ServiceRegistrar, srv SetupServer) {
+	// If the following call pancis, it indicates UnimplementedSetupServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Setup_ServiceDesc, srv)
+}
+
+func _Setup_ClaimSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetupServer).ClaimSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Setup_ClaimSetup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetupServer).ClaimSetup(ctx, req.(*SetupClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Setup_CompleteSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SetupServer).CompleteSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Setup_CompleteSetup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SetupServer).CompleteSetup(ctx, req.(*SetupResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Setup_ServiceDesc is the grpc.ServiceDesc for Setup service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Setup_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "load_generation_system_v1.Setup",
+	HandlerType: (*SetupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimSetup",
+			Handler:    _Setup_ClaimSetup_Handler,
+		},
+		{
+			MethodName: "CompleteSetup",
+			Handler:    _Setup_CompleteSetup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "load_generation_system_v1.proto",
+}
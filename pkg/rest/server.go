@@ -29,6 +29,7 @@ var fiberConfig = fiber.Config{
 	CaseSensitive:            true,
 	StrictRouting:            false,
 	EnableSplittingOnParsers: true,
+	BodyLimit:                64 * 1024 * 1024, // Datasets are uploaded as request bodies
 }
 
 func New(cfg Config) (srv Server) {
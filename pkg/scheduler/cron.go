@@ -0,0 +1,186 @@
+package scheduler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrBadCron is returned when a cron expression cannot be parsed.
+var ErrBadCron = errors.New("bad cron expression")
+
+// cronSearchLimit bounds the search for the next activation of a cron expression,
+// so that expressions never matching a real date (e.g. February 31) do not loop forever.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// cronField describes the range of values of a single cron expression field.
+type cronField struct {
+	min, max int
+}
+
+// cronFields lists the fields of a cron expression in their order:
+// minute, hour, day of month, month and day of week (0 is Sunday, 7 is accepted as Sunday too).
+var cronFields = []cronField{
+	{min: 0, max: 59},
+	{min: 0, max: 23},
+	{min: 1, max: 31},
+	{min: 1, max: 12},
+	{min: 0, max: 7},
+}
+
+// Cron is a parsed standard five-field cron expression.
+// The expression is evaluated in UTC.
+type Cron struct {
+	minutes  uint64 // Bit set of the matching minutes
+	hours    uint64 // Bit set of the matching hours
+	days     uint64 // Bit set of the matching days of month
+	months   uint64 // Bit set of the matching months
+	weekdays uint64 // Bit set of the matching days of week
+
+	anyDay     bool // Whether the day of month field is "*"
+	anyWeekday bool // Whether the day of week field is "*"
+}
+
+// ParseCron parses a five-field cron expression. Every field accepts "*", single values,
+// ranges ("1-5"), lists ("1,3,5") and steps ("*/15", "0-30/10").
+//
+// Parameters:
+//   - expr: The cron expression
+//
+// Returns:
+//   - Cron: The parsed expression
+//   - error: ErrBadCron if the expression is malformed
+func ParseCron(expr string) (Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return Cron{}, ErrBadCron
+	}
+
+	sets := make([]uint64, len(fields))
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return Cron{}, err
+		}
+		sets[i] = set
+	}
+
+	// Sunday can be written both as 0 and 7
+	weekdays := sets[4]
+	if weekdays&(1<<7) != 0 {
+		weekdays |= 1
+	}
+
+	return Cron{
+		minutes:    sets[0],
+		hours:      sets[1],
+		days:       sets[2],
+		months:     sets[3],
+		weekdays:   weekdays,
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField parses a single field of a cron expression into a bit set of matching values.
+//
+// Parameters:
+//   - field: The field text
+//   - bounds: The range of values of the field
+//
+// Returns:
+//   - uint64: Bit set of the matching values
+//   - error: ErrBadCron if the field is malformed
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, ErrBadCron
+			}
+		}
+
+		low, high := bounds.min, bounds.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			if low, err = strconv.Atoi(lowPart); err != nil {
+				return 0, ErrBadCron
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highPart); err != nil {
+					return 0, ErrBadCron
+				}
+			} else if hasStep {
+				// "5/15" means every 15 starting from 5
+				high = bounds.max
+			}
+		}
+
+		if low < bounds.min || high > bounds.max || low > high {
+			return 0, ErrBadCron
+		}
+
+		for value := low; value <= high; value += step {
+			set |= 1 << value
+		}
+	}
+
+	return set, nil
+}
+
+// Next returns the first activation of the expression strictly after the given moment.
+//
+// Parameters:
+//   - after: The moment to search from
+//
+// Returns:
+//   - time.Time: The next activation in UTC
+//   - bool: false if the expression has no activation within the search limit
+func (c Cron) Next(after time.Time) (time.Time, bool) {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case c.months&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hours&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minutes&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// matchesDay checks the day of month and day of week fields. As in the standard cron,
+// when both fields are restricted, a day matching either of them is accepted.
+//
+// Parameters:
+//   - t: The day to check
+//
+// Returns:
+//   - bool: Whether the day matches the expression
+func (c Cron) matchesDay(t time.Time) bool {
+	day := c.days&(1<<t.Day()) != 0
+	weekday := c.weekdays&(1<<int(t.Weekday())) != 0
+
+	if !c.anyDay && !c.anyWeekday {
+		return day || weekday
+	}
+
+	return day && weekday
+}
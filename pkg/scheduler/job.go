@@ -7,13 +7,15 @@ import (
 	"github.com/google/uuid"
 )
 
-// job represents a scheduled task that runs either at fixed intervals or continuously.
+// job represents a scheduled task that runs either at fixed intervals, continuously
+// or at the moments given by its schedule.
 // It provides mechanisms to start, stop, and manage the execution of the task.
 type job struct {
-	ID       string        // Unique identifier for the job
-	interval time.Duration // Interval between executions (0 means run continuously)
-	task     func()        // The function to execute
-	stop     chan any      // Channel to signal job termination
+	ID       string                                  // Unique identifier for the job
+	interval time.Duration                           // Interval between executions (0 means run continuously)
+	schedule func(after time.Time) (time.Time, bool) // Next execution moment, if set the interval is ignored
+	task     func()                                  // The function to execute
+	stop     chan any                                // Channel to signal job termination
 }
 
 func newJob(intervalSec float64, task func()) *job {
@@ -25,7 +27,17 @@ func newJob(intervalSec float64, task func()) *job {
 	}
 }
 
+func newScheduledJob(schedule func(after time.Time) (time.Time, bool), task func()) *job {
+	return &job{
+		ID:       uuid.NewString(), // Generate unique ID for the job
+		schedule: schedule,
+		task:     task,
+		stop:     make(chan any),
+	}
+}
+
 // run starts the job's execution loop. It runs in a goroutine and will either:
+// - Execute at the moments given by the schedule if it is set
 // - Execute continuously if interval is 0
 // - Execute at fixed intervals if interval > 0
 // The provided done function is called when the job stops to notify the scheduler.
@@ -37,6 +49,12 @@ func (j *job) run(ctx context.Context, done func()) {
 	// Ensure done callback is called when we exit
 	defer done()
 
+	// Scheduled execution mode
+	if j.schedule != nil {
+		j.runScheduled(ctx)
+		return
+	}
+
 	// Continuous execution mode (interval = 0)
 	if j.interval == 0 {
 		for {
@@ -75,6 +93,33 @@ func (j *job) run(ctx context.Context, done func()) {
 	}
 }
 
+// runScheduled executes the task at every moment given by the job schedule.
+// It returns once the schedule has no more moments or the job is stopped.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+func (j *job) runScheduled(ctx context.Context) {
+	for {
+		next, ok := j.schedule(time.Now())
+		if !ok {
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+			// Execute task in a goroutine to keep the schedule accurate
+			go j.task()
+		case <-j.stop: // Explicit stop signal
+			timer.Stop()
+			return
+		case <-ctx.Done(): // Context cancellation
+			timer.Stop()
+			return
+		}
+	}
+}
+
 // stopJob signals the job to stop execution by closing the stop channel.
 // This is safe to call multiple times as channel closing is idempotent.
 func (j *job) stopJob() {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"load-generation-system/internal/core"
 	"sync"
+	"time"
 )
 
 // ErrSchedulerStopped is returned when attempting to modify a scheduler that has been stopped.
@@ -36,6 +37,53 @@ func New() *Scheduler {
 }
 
 func (s *Scheduler) NewJob(intervalSec float64, task func()) (string, error) {
+	return s.addJob(newJob(intervalSec, task))
+}
+
+// NewOneShotJob creates a job executing the task once at the given moment.
+// The job is removed from the scheduler after the execution.
+//
+// Parameters:
+//   - at: The moment to execute the task at, moments in the past execute it immediately
+//   - task: The function to execute
+//
+// Returns:
+//   - string: ID of the created job
+//   - error: ErrSchedulerStopped if scheduler is stopped
+func (s *Scheduler) NewOneShotJob(at time.Time, task func()) (string, error) {
+	fired := false
+	return s.addJob(newScheduledJob(func(time.Time) (time.Time, bool) {
+		if fired {
+			return time.Time{}, false
+		}
+		fired = true
+		return at, true
+	}, task))
+}
+
+// NewCronJob creates a job executing the task at every activation of the cron expression.
+//
+// Parameters:
+//   - cron: The parsed cron expression
+//   - task: The function to execute
+//
+// Returns:
+//   - string: ID of the created job
+//   - error: ErrSchedulerStopped if scheduler is stopped
+func (s *Scheduler) NewCronJob(cron Cron, task func()) (string, error) {
+	return s.addJob(newScheduledJob(cron.Next, task))
+}
+
+// addJob registers a job and starts it in a separate goroutine.
+// The job is forgotten by the scheduler once its execution loop is over.
+//
+// Parameters:
+//   - j: The job to start
+//
+// Returns:
+//   - string: ID of the job
+//   - error: ErrSchedulerStopped if scheduler is stopped
+func (s *Scheduler) addJob(j *job) (string, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -43,13 +91,20 @@ func (s *Scheduler) NewJob(intervalSec float64, task func()) (string, error) {
 		return "", ErrSchedulerStopped
 	}
 
-	// Create and register new job
-	j := newJob(intervalSec, task)
+	// Register new job
 	s.jobs[j.ID] = j
 	s.wg.Add(1)
 
 	// Start job in separate goroutine
-	go j.run(s.ctx, s.wg.Done)
+	go j.run(s.ctx, func() {
+		s.mu.Lock()
+		if s.jobs[j.ID] == j {
+			delete(s.jobs, j.ID)
+		}
+		s.mu.Unlock()
+
+		s.wg.Done()
+	})
 
 	return j.ID, nil
 }